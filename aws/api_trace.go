@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// apiTrace records every API request made by the provider as a JSON line
+// in a file, along with a summary of the requests by service and operation
+// written when the trace is closed.
+type apiTrace struct {
+	mu sync.Mutex
+
+	filename  string
+	file      *os.File
+	encoder   *json.Encoder
+	throttles map[*request.Request]int
+	summary   map[string]*apiTraceSummary
+}
+
+// apiTraceRecord is the record of a request, written once the request is
+// complete, including all its retries.
+type apiTraceRecord struct {
+	Type       string    `json:"type"`
+	Time       time.Time `json:"time"`
+	Service    string    `json:"service"`
+	Operation  string    `json:"operation"`
+	Region     string    `json:"region"`
+	LatencyMs  int64     `json:"latency_ms"`
+	Retries    int       `json:"retries"`
+	Throttles  int       `json:"throttles"`
+	StatusCode int       `json:"status_code,omitempty"`
+	ErrorCode  string    `json:"error_code,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Resource   string    `json:"resource,omitempty"`
+}
+
+// apiTraceSummary holds the totals of the requests made to an operation.
+type apiTraceSummary struct {
+	Service        string `json:"service"`
+	Operation      string `json:"operation"`
+	Requests       int    `json:"requests"`
+	Errors         int    `json:"errors"`
+	Retries        int    `json:"retries"`
+	Throttles      int    `json:"throttles"`
+	TotalLatencyMs int64  `json:"total_latency_ms"`
+	MaxLatencyMs   int64  `json:"max_latency_ms"`
+}
+
+// apiTraces holds the open traces by file name, so provider instances
+// configured with the same file share it.
+var apiTraces = struct {
+	sync.Mutex
+	traces map[string]*apiTrace
+}{traces: make(map[string]*apiTrace)}
+
+// openApiTrace returns the trace writing to the file, which is created or
+// appended to.
+func openApiTrace(filename string) (*apiTrace, error) {
+	apiTraces.Lock()
+	defer apiTraces.Unlock()
+
+	if t, ok := apiTraces.traces[filename]; ok {
+		return t, nil
+	}
+
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening API trace file %s: %s", filename, err)
+	}
+
+	t := &apiTrace{
+		filename:  filename,
+		file:      f,
+		encoder:   json.NewEncoder(f),
+		throttles: make(map[*request.Request]int),
+		summary:   make(map[string]*apiTraceSummary),
+	}
+	apiTraces.traces[filename] = t
+
+	return t, nil
+}
+
+// CloseApiTraces writes the summary of the API requests to the open trace
+// files and closes them. It is called when the provider shuts down.
+func CloseApiTraces() {
+	apiTraces.Lock()
+	defer apiTraces.Unlock()
+
+	for filename, t := range apiTraces.traces {
+		if err := t.close(); err != nil {
+			log.Printf("[WARN] Error closing API trace file %s: %s", filename, err)
+		}
+		delete(apiTraces.traces, filename)
+	}
+}
+
+// addHandlers sets up the handlers recording the requests made by the
+// clients created from the session.
+func (t *apiTrace) addHandlers(handlers *request.Handlers) {
+	handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "terraform.ApiTraceThrottleHandler",
+		Fn:   t.countThrottle,
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.ApiTraceHandler",
+		Fn:   t.record,
+	})
+}
+
+// apiTraceResourceKey is the request context key of the resource making
+// the request.
+type apiTraceResourceKey struct{}
+
+// apiTraceResourceHandler returns a handler tagging the requests with the
+// resource making them, which is then written to their records.
+func apiTraceResourceHandler(resource string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.ApiTraceResourceHandler",
+		Fn: func(r *request.Request) {
+			r.SetContext(context.WithValue(r.Context(), apiTraceResourceKey{}, resource))
+		},
+	}
+}
+
+// apiTraceWrapResource wraps the functions of the resource so that, when
+// API requests are traced, the requests they make are recorded with the
+// resource. Terraform does not pass the address of the resource in the
+// configuration to its functions, so the resource is recorded by its type
+// and ID, e.g. aws_instance/i-1234567890abcdef0, or only by its type before
+// its ID is known. Data sources are recorded as data.TYPE.
+func apiTraceWrapResource(name string, r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, apiTraceMeta(name, d, meta))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			return exists(d, apiTraceMeta(name, d, meta))
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return state(d, apiTraceMeta(name, d, meta))
+		}
+	}
+}
+
+// apiTraceMeta returns the client tagging its requests with the resource,
+// or the client unchanged when API requests are not traced.
+func apiTraceMeta(name string, d *schema.ResourceData, meta interface{}) interface{} {
+	client, ok := meta.(*AWSClient)
+	if !ok || client.apiTraceResource == nil {
+		return meta
+	}
+
+	resource := name
+	if id := d.Id(); id != "" {
+		resource += "/" + id
+	}
+	return client.apiTraceResource(resource)
+}
+
+func (t *apiTrace) countThrottle(r *request.Request) {
+	if !r.IsErrorThrottle() {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.throttles[r]++
+}
+
+func (t *apiTrace) record(r *request.Request) {
+	rec := apiTraceRecord{
+		Type:      "request",
+		Time:      r.Time,
+		Service:   r.ClientInfo.ServiceName,
+		Operation: r.Operation.Name,
+		Region:    aws.StringValue(r.Config.Region),
+		LatencyMs: int64(time.Since(r.Time) / time.Millisecond),
+		Retries:   r.RetryCount,
+		RequestID: r.RequestID,
+	}
+	if r.HTTPResponse != nil {
+		rec.StatusCode = r.HTTPResponse.StatusCode
+	}
+	if err, ok := r.Error.(awserr.Error); ok && err != nil {
+		rec.ErrorCode = err.Code()
+	}
+	if resource, ok := r.Context().Value(apiTraceResourceKey{}).(string); ok {
+		rec.Resource = resource
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rec.Throttles = t.throttles[r]
+	delete(t.throttles, r)
+
+	key := rec.Service + "/" + rec.Operation
+	s, ok := t.summary[key]
+	if !ok {
+		s = &apiTraceSummary{Service: rec.Service, Operation: rec.Operation}
+		t.summary[key] = s
+	}
+	s.Requests++
+	if rec.ErrorCode != "" {
+		s.Errors++
+	}
+	s.Retries += rec.Retries
+	s.Throttles += rec.Throttles
+	s.TotalLatencyMs += rec.LatencyMs
+	if rec.LatencyMs > s.MaxLatencyMs {
+		s.MaxLatencyMs = rec.LatencyMs
+	}
+
+	if t.encoder == nil {
+		return
+	}
+	if err := t.encoder.Encode(rec); err != nil {
+		log.Printf("[WARN] Error writing to API trace file %s: %s", t.filename, err)
+	}
+}
+
+// summaries returns the totals of the operations, slowest first.
+func (t *apiTrace) summaries() []*apiTraceSummary {
+	summaries := make([]*apiTraceSummary, 0, len(t.summary))
+	for _, s := range t.summary {
+		summaries = append(summaries, s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].TotalLatencyMs != summaries[j].TotalLatencyMs {
+			return summaries[i].TotalLatencyMs > summaries[j].TotalLatencyMs
+		}
+		return summaries[i].Service+"/"+summaries[i].Operation < summaries[j].Service+"/"+summaries[j].Operation
+	})
+	return summaries
+}
+
+func (t *apiTrace) close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.encoder == nil {
+		return nil
+	}
+
+	summaries := t.summaries()
+	for _, s := range summaries {
+		log.Printf("[INFO] AWS API %s/%s: %d requests, %d errors, %d retries, %d throttles, %dms total, %dms max",
+			s.Service, s.Operation, s.Requests, s.Errors, s.Retries, s.Throttles, s.TotalLatencyMs, s.MaxLatencyMs)
+	}
+
+	err := t.encoder.Encode(struct {
+		Type       string             `json:"type"`
+		Time       time.Time          `json:"time"`
+		Operations []*apiTraceSummary `json:"operations"`
+	}{"summary", time.Now(), summaries})
+	t.encoder = nil
+
+	if closeErr := t.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestApiTrace(t *testing.T) {
+	dir := testAwsSharedConfigDir(t)
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "trace.jsonl")
+
+	closeFunc, sess, err := getMockedAwsApiSession("EC2", []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=DescribeAccountAttributes&" +
+				"AttributeName.1=supported-platforms&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeAccountAttributes_response, "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"POST", "/", "Action=DescribeRegions&Version=2016-11-15"},
+			Response: &awsMockResponse{503, test_ec2_requestLimitExceeded_response, "text/xml"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	trace, err := openApiTrace(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trace.addHandlers(&sess.Handlers)
+
+	conn := ec2.New(sess, &aws.Config{MaxRetries: aws.Int(2), SleepDelay: func(time.Duration) {}})
+	if _, err := GetSupportedEC2Platforms(conn); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if _, err := conn.DescribeRegions(&ec2.DescribeRegionsInput{}); err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			_, err := GetSupportedEC2Platforms(meta.(*AWSClient).ec2conn)
+			return err
+		},
+	}
+	apiTraceWrapResource("aws_test", r)
+
+	config := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-east-1",
+		ApiTraceFile:            filename,
+		Endpoints:               map[string]string{"ec2": aws.StringValue(sess.Config.Endpoint)},
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRegionValidation:    true,
+		SkipRequestingAccountId: true,
+		SkipMetadataApiCheck:    true,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	d := r.Data(nil)
+	d.SetId("test-id")
+	if err := r.Read(d, client); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	// The client itself is left unchanged by the resource
+	if _, err := GetSupportedEC2Platforms(client.(*AWSClient).ec2conn); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	CloseApiTraces()
+
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := make(map[string]interface{})
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("Error parsing trace line %q: %s", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 trace lines, got %d: %v", len(lines), lines)
+	}
+
+	expected := []map[string]interface{}{
+		{"type": "request", "service": "ec2", "operation": "DescribeAccountAttributes",
+			"retries": float64(0), "throttles": float64(0), "status_code": float64(200)},
+		{"type": "request", "service": "ec2", "operation": "DescribeRegions",
+			"retries": float64(2), "throttles": float64(3), "status_code": float64(503),
+			"error_code": "RequestLimitExceeded", "resource": nil},
+		{"type": "request", "service": "ec2", "operation": "DescribeAccountAttributes",
+			"retries": float64(0), "throttles": float64(0), "status_code": float64(200),
+			"resource": "aws_test/test-id"},
+		{"type": "request", "service": "ec2", "operation": "DescribeAccountAttributes",
+			"retries": float64(0), "throttles": float64(0), "status_code": float64(200),
+			"resource": nil},
+	}
+	for i, e := range expected {
+		for k, v := range e {
+			if lines[i][k] != v {
+				t.Fatalf("Line %d: expected %s to be %v, got %v", i, k, v, lines[i][k])
+			}
+		}
+	}
+
+	if lines[4]["type"] != "summary" {
+		t.Fatalf("Expected the last line to be the summary, got %v", lines[4])
+	}
+	if operations := lines[4]["operations"].([]interface{}); len(operations) != 2 {
+		t.Fatalf("Expected a summary of 2 operations, got %v", operations)
+	}
+}
+
+const test_ec2_requestLimitExceeded_response = `<Response>
+  <Errors>
+    <Error>
+      <Code>RequestLimitExceeded</Code>
+      <Message>Request limit exceeded.</Message>
+    </Error>
+  </Errors>
+  <RequestID>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestID>
+</Response>`
@@ -3,12 +3,14 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
@@ -97,12 +99,12 @@ func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string)
 	return parseAccountInfoFromArn(*outRoles.Roles[0].Arn)
 }
 
-func parseAccountInfoFromArn(arn string) (string, string, error) {
-	parts := strings.Split(arn, ":")
-	if len(parts) < 5 {
-		return "", "", fmt.Errorf("Unable to parse ID from invalid ARN: %q", arn)
+func parseAccountInfoFromArn(s string) (string, string, error) {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return "", "", fmt.Errorf("Unable to parse ID from invalid ARN: %q", s)
 	}
-	return parts[1], parts[4], nil
+	return a.Partition, a.AccountID, nil
 }
 
 // This function is responsible for reading credentials from the
@@ -123,6 +125,16 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		},
 	}
 
+	// Profiles getting credentials from an external process or by assuming
+	// a role are resolved from the shared config and credentials files.
+	sharedProvider, err := c.sharedConfigProvider()
+	if err != nil {
+		return nil, err
+	}
+	if sharedProvider != nil {
+		providers[2] = sharedProvider
+	}
+
 	// Build isolated HTTP client to avoid issues with globally-shared settings
 	client := cleanhttp.DefaultClient()
 
@@ -177,29 +189,67 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	}
 
 	// This is the "normal" flow (i.e. not assuming a role)
-	if c.AssumeRoleARN == "" {
+	if len(c.AssumeRoles) == 0 {
 		return awsCredentials.NewChainCredentials(providers), nil
 	}
 
-	// Otherwise we need to construct and STS client with the main credentials, and verify
-	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
-
 	creds := awsCredentials.NewChainCredentials(providers)
-	cp, err := creds.Get()
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, errors.New(`No valid credential sources found for AWS Provider.
+
+	// A role assumed with a web identity token doesn't need any credentials,
+	// otherwise we need the main credentials to assume the first role.
+	if c.AssumeRoles[0].WebIdentityTokenFile != "" {
+		creds = awsCredentials.AnonymousCredentials
+	} else {
+		cp, err := creds.Get()
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+				return nil, errors.New(`No valid credential sources found for AWS Provider.
   Please see https://terraform.io/docs/providers/aws/index.html for more information on
   providing credentials for the AWS Provider`)
+			}
+
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
 		}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
 	}
 
-	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	// Then we construct an STS client with the credentials of each hop, and
+	// verify that we can assume the next role of the chain.
+	for i, role := range c.AssumeRoles {
+		if role.WebIdentityTokenFile != "" && i > 0 {
+			return nil, fmt.Errorf("Only the first role assumed can use a web identity token file, not %q", role.RoleARN)
+		}
+
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, Duration: %ds, SerialNumber: %q, WebIdentityTokenFile: %q)",
+			role.RoleARN, role.SessionName, role.ExternalID, role.Policy, role.DurationSeconds, role.SerialNumber, role.WebIdentityTokenFile)
+
+		stsclient := sts.New(session.New(c.stsConfig(creds)))
+
+		providers = []awsCredentials.Provider{assumeRoleProvider(stsclient, role)}
+
+		creds = awsCredentials.NewChainCredentials(providers)
+		_, err := creds.Get()
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+				return nil, fmt.Errorf("The role %q cannot be assumed.\n\n"+
+					"  There are a number of possible causes of this - the most common are:\n"+
+					"    * The credentials used in order to assume the role are invalid\n"+
+					"    * The credentials do not have appropriate permission to assume the role\n"+
+					"    * The role ARN is not valid",
+					role.RoleARN)
+			}
+
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		}
+	}
+
+	return creds, nil
+}
 
+// stsConfig returns the configuration of the STS client used to assume
+// roles with the given credentials.
+func (c *Config) stsConfig(creds *awsCredentials.Credentials) *aws.Config {
 	awsConfig := &aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
@@ -207,40 +257,110 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		HTTPClient:       cleanhttp.DefaultClient(),
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 	}
+	if endpoint := c.Endpoints["sts"]; endpoint != "" {
+		awsConfig.Endpoint = aws.String(endpoint)
+	}
+
+	return awsConfig
+}
+
+// assumeRoleProvider returns the credentials provider assuming the role
+// with the STS client.
+func assumeRoleProvider(stsclient *sts.STS, role *AssumeRoleConfig) awsCredentials.Provider {
+	if role.WebIdentityTokenFile != "" {
+		p := &webIdentityRoleProvider{
+			Client:          stsclient,
+			RoleARN:         role.RoleARN,
+			RoleSessionName: role.SessionName,
+			TokenFile:       role.WebIdentityTokenFile,
+		}
+		if role.DurationSeconds > 0 {
+			p.Duration = time.Duration(role.DurationSeconds) * time.Second
+		}
+		if role.Policy != "" {
+			p.Policy = aws.String(role.Policy)
+		}
+		return p
+	}
 
-	stsclient := sts.New(session.New(awsConfig))
-	assumeRoleProvider := &stscreds.AssumeRoleProvider{
+	p := &stscreds.AssumeRoleProvider{
 		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+		RoleARN: role.RoleARN,
+	}
+	if role.SessionName != "" {
+		p.RoleSessionName = role.SessionName
 	}
-	if c.AssumeRoleSessionName != "" {
-		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	if role.ExternalID != "" {
+		p.ExternalID = aws.String(role.ExternalID)
 	}
-	if c.AssumeRoleExternalID != "" {
-		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	if role.Policy != "" {
+		p.Policy = aws.String(role.Policy)
 	}
-	if c.AssumeRolePolicy != "" {
-		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	if role.DurationSeconds > 0 {
+		p.Duration = time.Duration(role.DurationSeconds) * time.Second
 	}
+	if role.SerialNumber != "" {
+		p.SerialNumber = aws.String(role.SerialNumber)
+		if role.TokenCode != "" {
+			p.TokenCode = aws.String(role.TokenCode)
+		}
+	}
+	return p
+}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
+// webIdentityRoleProviderName is the name of the provider of the
+// credentials of roles assumed with a web identity token.
+const webIdentityRoleProviderName = "WebIdentityRoleProvider"
 
-	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
-	_, err = assumeRoleCreds.Get()
+// webIdentityRoleProvider retrieves the credentials of a role assumed with
+// AssumeRoleWithWebIdentity. The token is read from the file each time the
+// credentials are refreshed, so that tokens rotated by the CI are picked up.
+type webIdentityRoleProvider struct {
+	awsCredentials.Expiry
+
+	Client          *sts.STS
+	RoleARN         string
+	RoleSessionName string
+	Duration        time.Duration
+	Policy          *string
+	TokenFile       string
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := ioutil.ReadFile(p.TokenFile)
 	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, fmt.Errorf("The role %q cannot be assumed.\n\n"+
-				"  There are a number of possible causes of this - the most common are:\n"+
-				"    * The credentials used in order to assume the role are invalid\n"+
-				"    * The credentials do not have appropriate permission to assume the role\n"+
-				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
-		}
+		return awsCredentials.Value{ProviderName: webIdentityRoleProviderName},
+			fmt.Errorf("Error reading web identity token file %s: %s", p.TokenFile, err)
+	}
+
+	sessionName := p.RoleSessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+		Policy:           p.Policy,
+	}
+	if p.Duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.Duration / time.Second))
+	}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+	out, err := p.Client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityRoleProviderName}, err
 	}
 
-	return assumeRoleCreds, nil
+	p.SetExpiration(*out.Credentials.Expiration, 0)
+
+	return awsCredentials.Value{
+		AccessKeyID:     *out.Credentials.AccessKeyId,
+		SecretAccessKey: *out.Credentials.SecretAccessKey,
+		SessionToken:    *out.Credentials.SessionToken,
+		ProviderName:    webIdentityRoleProviderName,
+	}, nil
 }
 
 func setOptionalEndpoint(cfg *aws.Config) string {
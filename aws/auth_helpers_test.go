@@ -586,6 +586,185 @@ func TestAWSGetCredentials_shouldCatchEC2RoleProvider(t *testing.T) {
 	}
 }
 
+func TestAWSGetCredentials_assumeRole(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	ts := getMockedAwsApiServer("STS", []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"ExternalId=external&RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fbastion&" +
+				"RoleSessionName=terraform&Version=2011-06-15"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRole_valid, "BASTIONKEY"), "text/xml"},
+		},
+	})
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		Region:               "us-west-2",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": ts.URL},
+		AssumeRoles: []*AssumeRoleConfig{
+			{
+				RoleARN:         "arn:aws:iam::111111111111:role/bastion",
+				SessionName:     "terraform",
+				ExternalID:      "external",
+				DurationSeconds: 900,
+			},
+		},
+	}
+
+	testAWSGetCredentialsAccessKey(t, &cfg, "BASTIONKEY")
+}
+
+func TestAWSGetCredentials_assumeRoleChainWithMFA(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	ts := getMockedAwsApiServer("STS", []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fbastion&RoleSessionName=terraform&" +
+				"SerialNumber=arn%3Aaws%3Aiam%3A%3A111111111111%3Amfa%2Fuser&TokenCode=123456&Version=2011-06-15"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRole_valid, "BASTIONKEY"), "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A222222222222%3Arole%2Fworkload&RoleSessionName=terraform&" +
+				"Version=2011-06-15"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRole_valid, "WORKLOADKEY"), "text/xml"},
+		},
+	})
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		Region:               "us-west-2",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": ts.URL},
+		AssumeRoles: []*AssumeRoleConfig{
+			{
+				RoleARN:      "arn:aws:iam::111111111111:role/bastion",
+				SessionName:  "terraform",
+				SerialNumber: "arn:aws:iam::111111111111:mfa/user",
+				TokenCode:    "123456",
+			},
+			{
+				RoleARN:     "arn:aws:iam::222222222222:role/workload",
+				SessionName: "terraform",
+			},
+		},
+	}
+
+	testAWSGetCredentialsAccessKey(t, &cfg, "WORKLOADKEY")
+}
+
+func TestAWSGetCredentials_assumeRoleWithWebIdentity(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	tokenFile, err := ioutil.TempFile("", "tf-aws-web-identity")
+	if err != nil {
+		t.Fatalf("Error creating token file: %s", err)
+	}
+	defer os.Remove(tokenFile.Name())
+	if _, err := tokenFile.WriteString("oidc-token\n"); err != nil {
+		t.Fatalf("Error writing token file: %s", err)
+	}
+	tokenFile.Close()
+
+	ts := getMockedAwsApiServer("STS", []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRoleWithWebIdentity&DurationSeconds=3600&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fci&RoleSessionName=ci&" +
+				"Version=2011-06-15&WebIdentityToken=oidc-token"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRoleWithWebIdentity_valid, "CIKEY"), "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A222222222222%3Arole%2Fworkload&RoleSessionName=ci&" +
+				"Version=2011-06-15"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRole_valid, "WORKLOADKEY"), "text/xml"},
+		},
+	})
+	defer ts.Close()
+
+	cfg := Config{
+		Region:               "us-west-2",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": ts.URL},
+		AssumeRoles: []*AssumeRoleConfig{
+			{
+				RoleARN:              "arn:aws:iam::111111111111:role/ci",
+				SessionName:          "ci",
+				DurationSeconds:      3600,
+				WebIdentityTokenFile: tokenFile.Name(),
+			},
+			{
+				RoleARN:     "arn:aws:iam::222222222222:role/workload",
+				SessionName: "ci",
+			},
+		},
+	}
+
+	testAWSGetCredentialsAccessKey(t, &cfg, "WORKLOADKEY")
+}
+
+func TestAWSGetCredentials_webIdentityOnlyFirst(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	ts := getMockedAwsApiServer("STS", []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fbastion&RoleSessionName=terraform&" +
+				"Version=2011-06-15"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRole_valid, "BASTIONKEY"), "text/xml"},
+		},
+	})
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		Region:               "us-west-2",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": ts.URL},
+		AssumeRoles: []*AssumeRoleConfig{
+			{
+				RoleARN:     "arn:aws:iam::111111111111:role/bastion",
+				SessionName: "terraform",
+			},
+			{
+				RoleARN:              "arn:aws:iam::222222222222:role/ci",
+				WebIdentityTokenFile: "/tmp/token",
+			},
+		},
+	}
+
+	if _, err := GetCredentials(&cfg); err == nil {
+		t.Fatal("Expected an error when using a web identity token file for a later role")
+	}
+}
+
+func testAWSGetCredentialsAccessKey(t *testing.T, cfg *Config, expected string) {
+	creds, err := GetCredentials(cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.AccessKeyID != expected {
+		t.Fatalf("AccessKeyID mismatch, expected: (%s), got (%s)", expected, v.AccessKeyID)
+	}
+}
+
 var credentialsFileContents = `[myprofile]
 aws_access_key_id = accesskey
 aws_secret_access_key = secretkey
@@ -932,3 +1111,31 @@ const iamResponse_ListRoles_unauthorized = `<ErrorResponse xmlns="https://iam.am
   </Error>
   <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
 </ErrorResponse>`
+
+const stsResponse_AssumeRole_valid = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%s</AccessKeyId>
+      <SecretAccessKey>secretKey</SecretAccessKey>
+      <SessionToken>sessionToken</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+const stsResponse_AssumeRoleWithWebIdentity_valid = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>%s</AccessKeyId>
+      <SecretAccessKey>secretKey</SecretAccessKey>
+      <SessionToken>sessionToken</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
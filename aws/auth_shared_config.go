@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/go-ini/ini"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// sharedProfile holds the settings of a profile of the shared config and
+// credentials files which are relevant to the provider credentials.
+type sharedProfile map[string]string
+
+// sharedConfigProvider returns the provider of the credentials of the
+// configured profile when the profile gets them from an external process or
+// by assuming a role. It returns nil for profiles holding plain keys, which
+// are left to the SharedCredentialsProvider, and when static or environment
+// credentials are set, as they come first in the chain.
+func (c *Config) sharedConfigProvider() (awsCredentials.Provider, error) {
+	if c.AccessKey != "" && c.SecretKey != "" {
+		log.Print("[DEBUG] Static credentials are set, not resolving the shared config profile")
+		return nil, nil
+	}
+	if hasEnvCredentials() {
+		log.Print("[DEBUG] Environment credentials are set, not resolving the shared config profile")
+		return nil, nil
+	}
+
+	profiles, err := c.loadSharedProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	name := c.sharedProfileName()
+	profile, ok := profiles[name]
+	if !ok || (profile["credential_process"] == "" && profile["role_arn"] == "") {
+		return nil, nil
+	}
+
+	return c.sharedProfileProvider(profiles, name, map[string]bool{})
+}
+
+// hasEnvCredentials returns whether the EnvProvider finds credentials in the
+// environment.
+func hasEnvCredentials() bool {
+	accessKey := os.Getenv("AWS_ACCESS_KEY_ID")
+	if accessKey == "" {
+		accessKey = os.Getenv("AWS_ACCESS_KEY")
+	}
+	secretKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if secretKey == "" {
+		secretKey = os.Getenv("AWS_SECRET_KEY")
+	}
+	return accessKey != "" && secretKey != ""
+}
+
+// sharedProfileProvider returns the provider of the credentials of the
+// profile, following its source_profile chain.
+func (c *Config) sharedProfileProvider(profiles map[string]sharedProfile, name string, visited map[string]bool) (awsCredentials.Provider, error) {
+	if visited[name] {
+		return nil, fmt.Errorf("Circular source_profile reference found in profile %q", name)
+	}
+	visited[name] = true
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("Profile %q not found in the shared config or credentials file", name)
+	}
+
+	if v := profile["role_arn"]; v != "" {
+		source := profile["source_profile"]
+		if source == "" {
+			return nil, fmt.Errorf("Profile %q sets role_arn without source_profile", name)
+		}
+
+		sourceProvider, err := c.sharedProfileProvider(profiles, source, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("[INFO] Profile %q assumes role %s with the credentials of profile %q", name, v, source)
+
+		stsclient := sts.New(session.New(c.stsConfig(awsCredentials.NewCredentials(sourceProvider))))
+		p := &stscreds.AssumeRoleProvider{
+			Client:          stsclient,
+			RoleARN:         v,
+			RoleSessionName: profile["role_session_name"],
+		}
+		if v := profile["external_id"]; v != "" {
+			p.ExternalID = aws.String(v)
+		}
+		if v := profile["duration_seconds"]; v != "" {
+			seconds, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid duration_seconds %q in profile %q: %s", v, name, err)
+			}
+			p.Duration = time.Duration(seconds) * time.Second
+		}
+		if v := profile["mfa_serial"]; v != "" {
+			return nil, fmt.Errorf("Profile %q requires MFA, which is not supported in profiles. "+
+				"Use serial_number and token_code in the assume_role block instead", name)
+		}
+		return p, nil
+	}
+
+	if v := profile["credential_process"]; v != "" {
+		log.Printf("[INFO] Profile %q gets credentials from an external process", name)
+		return &processCredentialsProvider{Command: v}, nil
+	}
+
+	if profile["aws_access_key_id"] != "" {
+		return &awsCredentials.StaticProvider{Value: awsCredentials.Value{
+			AccessKeyID:     profile["aws_access_key_id"],
+			SecretAccessKey: profile["aws_secret_access_key"],
+			SessionToken:    profile["aws_session_token"],
+			ProviderName:    awsCredentials.SharedCredsProviderName,
+		}}, nil
+	}
+
+	return nil, fmt.Errorf("Profile %q has neither credentials, credential_process nor role_arn", name)
+}
+
+// sharedProfileName returns the name of the profile used by the provider.
+func (c *Config) sharedProfileName() string {
+	if c.Profile != "" {
+		return c.Profile
+	}
+	if v := os.Getenv("AWS_PROFILE"); v != "" {
+		return v
+	}
+	return "default"
+}
+
+// loadSharedProfiles reads the profiles of the shared config file and of the
+// shared credentials file. Settings of the credentials file take precedence.
+func (c *Config) loadSharedProfiles() (map[string]sharedProfile, error) {
+	profiles := make(map[string]sharedProfile)
+
+	configFilename, err := c.sharedConfigFilename()
+	if err != nil {
+		return nil, err
+	}
+	credsFilename, err := c.sharedCredentialsFilename()
+	if err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		Filename      string
+		SectionPrefix string
+	}{
+		{configFilename, "profile "},
+		{credsFilename, ""},
+	}
+
+	for _, file := range files {
+		f, err := ini.Load(file.Filename)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("Error loading shared config file %s: %s", file.Filename, err)
+		}
+
+		for _, section := range f.Sections() {
+			name := strings.TrimPrefix(section.Name(), file.SectionPrefix)
+			if name == ini.DEFAULT_SECTION {
+				continue
+			}
+
+			profile, ok := profiles[name]
+			if !ok {
+				profile = make(sharedProfile)
+				profiles[name] = profile
+			}
+			for k, v := range section.KeysHash() {
+				profile[k] = v
+			}
+		}
+	}
+
+	return profiles, nil
+}
+
+func (c *Config) sharedConfigFilename() (string, error) {
+	if c.ConfigFilename != "" {
+		return c.ConfigFilename, nil
+	}
+	if v := os.Getenv("AWS_CONFIG_FILE"); v != "" {
+		return v, nil
+	}
+	return homedir.Expand(filepath.Join("~", ".aws", "config"))
+}
+
+func (c *Config) sharedCredentialsFilename() (string, error) {
+	if c.CredsFilename != "" {
+		return c.CredsFilename, nil
+	}
+	if v := os.Getenv("AWS_SHARED_CREDENTIALS_FILE"); v != "" {
+		return v, nil
+	}
+	return homedir.Expand(filepath.Join("~", ".aws", "credentials"))
+}
+
+// processCredentialsProviderName is the name of the provider of the
+// credentials returned by an external process.
+const processCredentialsProviderName = "ProcessProvider"
+
+// processCredentialsProvider retrieves credentials from the JSON output of
+// the credential_process command of a profile. Credentials without an
+// expiration are never refreshed.
+type processCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	Command string
+
+	expires bool
+}
+
+// processCredentialsOutput is the output expected from the command, as
+// documented for the credential_process setting of the AWS CLI.
+type processCredentialsOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+func (p *processCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.Command("sh", "-c", p.Command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = os.Environ()
+
+	if err := cmd.Run(); err != nil {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("Error running credential_process %q: %s: %s", p.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var out processCredentialsOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("Error parsing the output of credential_process %q: %s", p.Command, err)
+	}
+	if out.Version != 1 {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("Unsupported version %d of the output of credential_process %q", out.Version, p.Command)
+	}
+	if out.AccessKeyId == "" || out.SecretAccessKey == "" {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			fmt.Errorf("Missing AccessKeyId or SecretAccessKey in the output of credential_process %q", p.Command)
+	}
+
+	p.expires = out.Expiration != nil
+	if p.expires {
+		p.SetExpiration(*out.Expiration, 0)
+	}
+
+	return awsCredentials.Value{
+		AccessKeyID:     out.AccessKeyId,
+		SecretAccessKey: out.SecretAccessKey,
+		SessionToken:    out.SessionToken,
+		ProviderName:    processCredentialsProviderName,
+	}, nil
+}
+
+func (p *processCredentialsProvider) IsExpired() bool {
+	if !p.expires {
+		return false
+	}
+	return p.Expiry.IsExpired()
+}
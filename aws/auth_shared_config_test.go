@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAWSGetCredentials_credentialProcess(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	dir := testAwsSharedConfigDir(t)
+	defer os.RemoveAll(dir)
+
+	testAwsSharedConfigFile(t, dir, "process.sh", fmt.Sprintf(credentialProcessScript, "PROCESSKEY",
+		`, "Expiration": "2099-01-01T00:00:00Z"`))
+	testAwsSharedConfigFile(t, dir, "config", fmt.Sprintf(`[profile process]
+credential_process = sh %s
+`, filepath.Join(dir, "process.sh")))
+
+	cfg := Config{
+		Profile:              "process",
+		ConfigFilename:       filepath.Join(dir, "config"),
+		CredsFilename:        filepath.Join(dir, "credentials"),
+		SkipMetadataApiCheck: true,
+	}
+
+	testAWSGetCredentialsAccessKey(t, &cfg, "PROCESSKEY")
+}
+
+func TestAWSGetCredentials_sourceProfileChain(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	dir := testAwsSharedConfigDir(t)
+	defer os.RemoveAll(dir)
+
+	ts := getMockedAwsApiServer("STS", []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fbastion&RoleSessionName=terraform&" +
+				"Version=2011-06-15"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRole_valid, "BASTIONKEY"), "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=3600&" +
+				"ExternalId=external&RoleArn=arn%3Aaws%3Aiam%3A%3A222222222222%3Arole%2Fworkload&" +
+				"RoleSessionName=terraform&Version=2011-06-15"},
+			Response: &awsMockResponse{200, fmt.Sprintf(stsResponse_AssumeRole_valid, "WORKLOADKEY"), "text/xml"},
+		},
+	})
+	defer ts.Close()
+
+	testAwsSharedConfigFile(t, dir, "credentials", `[base]
+aws_access_key_id = accessKey
+aws_secret_access_key = secretKey
+`)
+	testAwsSharedConfigFile(t, dir, "config", `[profile bastion]
+role_arn = arn:aws:iam::111111111111:role/bastion
+source_profile = base
+role_session_name = terraform
+
+[profile workload]
+role_arn = arn:aws:iam::222222222222:role/workload
+source_profile = bastion
+role_session_name = terraform
+external_id = external
+duration_seconds = 3600
+`)
+
+	cfg := Config{
+		Profile:              "workload",
+		Region:               "us-west-2",
+		ConfigFilename:       filepath.Join(dir, "config"),
+		CredsFilename:        filepath.Join(dir, "credentials"),
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": ts.URL},
+	}
+
+	testAWSGetCredentialsAccessKey(t, &cfg, "WORKLOADKEY")
+}
+
+func TestAWSGetCredentials_staticSkipsSharedConfig(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	dir := testAwsSharedConfigDir(t)
+	defer os.RemoveAll(dir)
+
+	testAwsSharedConfigFile(t, dir, "config", `[default]
+role_arn = arn:aws:iam::111111111111:role/mfa
+source_profile = base
+mfa_serial = arn:aws:iam::111111111111:mfa/user
+`)
+
+	cfg := Config{
+		AccessKey:            "StaticAccessKey",
+		SecretKey:            "StaticSecretKey",
+		ConfigFilename:       filepath.Join(dir, "config"),
+		CredsFilename:        filepath.Join(dir, "credentials"),
+		SkipMetadataApiCheck: true,
+	}
+
+	testAWSGetCredentialsAccessKey(t, &cfg, "StaticAccessKey")
+
+	cfg.AccessKey = ""
+	cfg.SecretKey = ""
+	if err := os.Setenv("AWS_ACCESS_KEY_ID", "EnvAccessKey"); err != nil {
+		t.Fatalf("Error setting env var AWS_ACCESS_KEY_ID: %s", err)
+	}
+	if err := os.Setenv("AWS_SECRET_ACCESS_KEY", "EnvSecretKey"); err != nil {
+		t.Fatalf("Error setting env var AWS_SECRET_ACCESS_KEY: %s", err)
+	}
+
+	testAWSGetCredentialsAccessKey(t, &cfg, "EnvAccessKey")
+}
+
+func TestAWSGetCredentials_sourceProfileCycle(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	dir := testAwsSharedConfigDir(t)
+	defer os.RemoveAll(dir)
+
+	testAwsSharedConfigFile(t, dir, "config", `[profile one]
+role_arn = arn:aws:iam::111111111111:role/one
+source_profile = two
+
+[profile two]
+role_arn = arn:aws:iam::111111111111:role/two
+source_profile = one
+`)
+
+	cfg := Config{
+		Profile:              "one",
+		ConfigFilename:       filepath.Join(dir, "config"),
+		CredsFilename:        filepath.Join(dir, "credentials"),
+		SkipMetadataApiCheck: true,
+	}
+
+	_, err := GetCredentials(&cfg)
+	if err == nil || !strings.Contains(err.Error(), "Circular source_profile") {
+		t.Fatalf("Expected a circular source_profile error, got: %v", err)
+	}
+}
+
+func TestProcessCredentialsProvider(t *testing.T) {
+	dir := testAwsSharedConfigDir(t)
+	defer os.RemoveAll(dir)
+
+	testAwsSharedConfigFile(t, dir, "static.sh", fmt.Sprintf(credentialProcessScript, "STATICKEY", ""))
+	testAwsSharedConfigFile(t, dir, "expired.sh", fmt.Sprintf(credentialProcessScript, "EXPIREDKEY",
+		`, "Expiration": "2000-01-01T00:00:00Z"`))
+	testAwsSharedConfigFile(t, dir, "version.sh", `echo '{"Version": 2}'`)
+	testAwsSharedConfigFile(t, dir, "fail.sh", `echo "no credentials" >&2; exit 1`)
+
+	cases := []struct {
+		Script    string
+		Key       string
+		IsExpired bool
+		Error     string
+	}{
+		{Script: "static.sh", Key: "STATICKEY"},
+		{Script: "expired.sh", Key: "EXPIREDKEY", IsExpired: true},
+		{Script: "version.sh", Error: "Unsupported version 2"},
+		{Script: "fail.sh", Error: "no credentials"},
+	}
+
+	for _, tc := range cases {
+		p := &processCredentialsProvider{Command: "sh " + filepath.Join(dir, tc.Script)}
+
+		v, err := p.Retrieve()
+		if tc.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("%s: expected error containing %q, got: %v", tc.Script, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Script, err)
+		}
+		if v.AccessKeyID != tc.Key {
+			t.Fatalf("%s: expected AccessKeyID %q, got %q", tc.Script, tc.Key, v.AccessKeyID)
+		}
+		if p.IsExpired() != tc.IsExpired {
+			t.Fatalf("%s: expected IsExpired to be %t", tc.Script, tc.IsExpired)
+		}
+	}
+}
+
+func testAwsSharedConfigDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "tf-aws-shared-config")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	return dir
+}
+
+func testAwsSharedConfigFile(t *testing.T, dir, name, content string) {
+	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+		t.Fatalf("Error writing %s: %s", name, err)
+	}
+}
+
+const credentialProcessScript = `cat <<EOF
+{"Version": 1, "AccessKeyId": "%s", "SecretAccessKey": "secretKey", "SessionToken": "sessionToken"%s}
+EOF
+`
@@ -0,0 +1,449 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// awsMockRecordEnvVar is the environment variable that switches the mocked
+// AWS API from replaying fixtures to recording them against the real APIs.
+const awsMockRecordEnvVar = "TF_AWS_MOCK_RECORD"
+
+// awsMockRegion is the region every mocked provider is configured with.
+const awsMockRegion = "us-west-2"
+
+// awsMock is an offline stand-in for the AWS APIs, allowing resources to be
+// unit tested with resource.UnitTest. A single httptest server is wired into
+// every configurable endpoint of the provider Config; requests are attributed
+// to a service by their signature and to an operation by their protocol, and
+// answered with the responses recorded in a fixture file.
+//
+// With TF_AWS_MOCK_RECORD set, requests are instead re-signed with the
+// credentials from the environment, forwarded to the real AWS APIs, and the
+// responses are written to the fixture file when the mock is closed.
+type awsMock struct {
+	t        *testing.T
+	fixture  string
+	record   bool
+	server   *httptest.Server
+	provider *schema.Provider
+	signer   *v4.Signer
+
+	mu           sync.Mutex
+	interactions []*awsMockInteraction
+	used         []bool
+	cursor       int
+}
+
+// awsMockInteraction is a single recorded request and its response.
+type awsMockInteraction struct {
+	Service     string            `json:"service"`
+	Operation   string            `json:"operation"`
+	StatusCode  int               `json:"status_code"`
+	ContentType string            `json:"content_type,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Body        string            `json:"body"`
+}
+
+// newAwsMock starts a mocked AWS API serving the named fixture from
+// test-fixtures/mock. The returned mock must be closed once the test is done.
+func newAwsMock(t *testing.T, name string) *awsMock {
+	m := &awsMock{
+		t:       t,
+		fixture: filepath.Join("test-fixtures", "mock", name+".json"),
+		record:  os.Getenv(awsMockRecordEnvVar) != "",
+		cursor:  -1,
+	}
+
+	if m.record {
+		creds := credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvProvider{},
+			&credentials.SharedCredentialsProvider{},
+		})
+		m.signer = v4.NewSigner(creds)
+	} else {
+		b, err := ioutil.ReadFile(m.fixture)
+		if err != nil {
+			t.Fatalf("Error reading mock fixture %s: %s", m.fixture, err)
+		}
+		if err := json.Unmarshal(b, &m.interactions); err != nil {
+			t.Fatalf("Error parsing mock fixture %s: %s", m.fixture, err)
+		}
+		m.used = make([]bool, len(m.interactions))
+	}
+
+	m.server = httptest.NewServer(m)
+
+	m.provider = Provider().(*schema.Provider)
+	m.provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return m.Config().Client()
+	}
+
+	return m
+}
+
+// Close stops the mocked API, and writes the fixture when recording.
+func (m *awsMock) Close() {
+	m.server.Close()
+
+	if !m.record {
+		for n, used := range m.used {
+			if !used {
+				i := m.interactions[n]
+				log.Printf("[WARN] Mocked %s %s response was never used", i.Service, i.Operation)
+			}
+		}
+		return
+	}
+
+	b, err := json.MarshalIndent(m.interactions, "", "  ")
+	if err != nil {
+		m.t.Fatalf("Error serializing mock fixture %s: %s", m.fixture, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(m.fixture), 0755); err != nil {
+		m.t.Fatalf("Error writing mock fixture %s: %s", m.fixture, err)
+	}
+	if err := ioutil.WriteFile(m.fixture, append(b, '\n'), 0644); err != nil {
+		m.t.Fatalf("Error writing mock fixture %s: %s", m.fixture, err)
+	}
+}
+
+// Config returns a provider Config whose endpoints all point to the mock.
+func (m *awsMock) Config() *Config {
+	config := &Config{
+		AccessKey:               "mock-access-key",
+		SecretKey:               "mock-secret-key",
+		Region:                  awsMockRegion,
+		MaxRetries:              0,
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRegionValidation:    true,
+		SkipRequestingAccountId: true,
+		SkipMetadataApiCheck:    true,
+		S3ForcePathStyle:        true,
+	}
+
+	config.Endpoints = make(map[string]string)
+	for _, svc := range awsServiceClients {
+		config.Endpoints[svc.Name] = m.server.URL
+	}
+
+	return config
+}
+
+// Providers returns the providers to run resource.UnitTest with.
+func (m *awsMock) Providers() map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{
+		"aws": m.provider,
+	}
+}
+
+// Meta returns the AWSClient of the configured provider, for use in checks.
+func (m *awsMock) Meta() *AWSClient {
+	return m.provider.Meta().(*AWSClient)
+}
+
+func (m *awsMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		m.t.Errorf("Error reading mocked request body: %s", err)
+		w.WriteHeader(500)
+		return
+	}
+
+	service, region := awsMockRequestScope(r)
+	operation := awsMockRequestOperation(r, body)
+	log.Printf("[DEBUG] Mocked AWS API received %s %s request: %s", service, operation, body)
+
+	var i *awsMockInteraction
+	if m.record {
+		i, err = m.forward(service, operation, region, r, body)
+		if err != nil {
+			m.t.Errorf("Error forwarding %s %s request: %s", service, operation, err)
+			w.WriteHeader(500)
+			return
+		}
+	} else {
+		i = m.replay(service, operation)
+		if i == nil {
+			m.t.Errorf("Unexpected %s %s request, no response in %s", service, operation, m.fixture)
+			w.WriteHeader(400)
+			return
+		}
+	}
+
+	for k, v := range i.Headers {
+		w.Header().Set(k, v)
+	}
+	if i.ContentType != "" {
+		w.Header().Set("Content-Type", i.ContentType)
+	}
+	w.Header().Set("X-Amzn-Requestid", "1b206dd1-f9a8-11e5-becf-051c60f11c4a")
+	w.Header().Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	w.WriteHeader(i.StatusCode)
+	fmt.Fprint(w, i.Body)
+}
+
+// replay finds the response to the next request for an operation. Fixtures
+// are played in order: the interaction following the last one served is
+// used when it matches, otherwise the latest matching interaction already
+// served is repeated, so that fixtures only need to list the responses of
+// repeated calls (e.g. reads while refreshing) when they change. As a last
+// resort the next unused matching interaction is used.
+func (m *awsMock) replay(service, operation string) *awsMockInteraction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	matches := func(i int) bool {
+		return m.interactions[i].Service == service && m.interactions[i].Operation == operation
+	}
+	serve := func(i int) *awsMockInteraction {
+		m.used[i] = true
+		if i > m.cursor {
+			m.cursor = i
+		}
+		return m.interactions[i]
+	}
+
+	if next := m.cursor + 1; next < len(m.interactions) && matches(next) {
+		return serve(next)
+	}
+	for i := m.cursor; i >= 0; i-- {
+		if matches(i) {
+			return serve(i)
+		}
+	}
+	for i := m.cursor + 1; i < len(m.interactions); i++ {
+		if !m.used[i] && matches(i) {
+			return serve(i)
+		}
+	}
+
+	return nil
+}
+
+// forward sends the request to the real AWS API and records the response.
+func (m *awsMock) forward(service, operation, region string, r *http.Request, body []byte) (*awsMockInteraction, error) {
+	endpoint, err := endpoints.DefaultResolver().EndpointFor(service, region)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(r.Method, endpoint.URL+r.URL.RequestURI(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range r.Header {
+		switch http.CanonicalHeaderKey(k) {
+		case "Authorization", "X-Amz-Date", "X-Amz-Content-Sha256", "X-Amz-Security-Token":
+			continue
+		}
+		req.Header[k] = v
+	}
+	if _, err := m.signer.Sign(req, bytes.NewReader(body), service, endpoint.SigningRegion, time.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &awsMockInteraction{
+		Service:     service,
+		Operation:   operation,
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        string(respBody),
+	}
+	for k := range resp.Header {
+		switch k {
+		case "Content-Type", "Content-Length", "Date", "Connection", "Server", "Transfer-Encoding":
+			continue
+		}
+		if i.Headers == nil {
+			i.Headers = make(map[string]string)
+		}
+		i.Headers[k] = resp.Header.Get(k)
+	}
+
+	m.mu.Lock()
+	m.interactions = append(m.interactions, i)
+	m.mu.Unlock()
+
+	return i, nil
+}
+
+var awsMockCredentialScope = regexp.MustCompile(`Credential=[^/]+/[^/]+/([^/]+)/([^/]+)/aws4_request`)
+
+// awsMockRequestScope returns the service and region a request is signed for.
+func awsMockRequestScope(r *http.Request) (string, string) {
+	m := awsMockCredentialScope.FindStringSubmatch(r.Header.Get("Authorization"))
+	if m == nil {
+		return "", ""
+	}
+	return m[2], m[1]
+}
+
+// awsMockRequestOperation returns the name of the API operation of a request.
+func awsMockRequestOperation(r *http.Request, body []byte) string {
+	// JSON protocols name the operation in the target header
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		return target[strings.LastIndex(target, ".")+1:]
+	}
+
+	// Query protocols (including EC2) send it as the Action parameter
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil && values.Get("Action") != "" {
+			return values.Get("Action")
+		}
+	}
+	if action := r.URL.Query().Get("Action"); action != "" {
+		return action
+	}
+
+	// REST protocols are identified by their method and path
+	return awsMockRestOperation(r)
+}
+
+// awsMockS3Subresources maps S3 sub-resources to the name they have in
+// the operations using them.
+var awsMockS3Subresources = map[string]string{
+	"accelerate":     "AccelerateConfiguration",
+	"acl":            "Acl",
+	"cors":           "Cors",
+	"lifecycle":      "LifecycleConfiguration",
+	"location":       "Location",
+	"logging":        "Logging",
+	"notification":   "NotificationConfiguration",
+	"policy":         "Policy",
+	"replication":    "Replication",
+	"requestPayment": "RequestPayment",
+	"tagging":        "Tagging",
+	"versioning":     "Versioning",
+	"website":        "Website",
+}
+
+var awsMockRestVerbs = map[string]string{
+	"DELETE": "Delete",
+	"GET":    "Get",
+	"HEAD":   "Head",
+	"POST":   "Post",
+	"PUT":    "Put",
+}
+
+// awsMockRestOperation names the operation of a REST request. Requests in
+// the S3 path style are mapped to the S3 operation names, e.g. GetBucketTagging
+// or PutObject; other services are named after the method and path, e.g.
+// "GET /restapis/abc123".
+func awsMockRestOperation(r *http.Request) string {
+	service, _ := awsMockRequestScope(r)
+	if service != "s3" {
+		return r.Method + " " + r.URL.Path
+	}
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+	verb := awsMockRestVerbs[r.Method]
+
+	if path[0] == "" {
+		return "ListBuckets"
+	}
+
+	resource := "Bucket"
+	if len(path) > 1 {
+		resource = "Object"
+	}
+	for k, name := range awsMockS3Subresources {
+		if _, ok := query[k]; ok {
+			return verb + resource + name
+		}
+	}
+
+	if resource == "Object" {
+		if r.Header.Get("X-Amz-Copy-Source") != "" {
+			return "CopyObject"
+		}
+		return verb + "Object"
+	}
+
+	if _, ok := query["versions"]; ok {
+		return "ListObjectVersions"
+	}
+	if _, ok := query["delete"]; ok {
+		return "DeleteObjects"
+	}
+	switch r.Method {
+	case "GET":
+		return "ListObjects"
+	case "PUT":
+		return "CreateBucket"
+	}
+	return verb + "Bucket"
+}
+
+func TestAwsMockRequestOperation(t *testing.T) {
+	cases := []struct {
+		Method, Url, ContentType, Target, Service, Body string
+		Operation                                       string
+	}{
+		// JSON
+		{"POST", "/", "application/x-amz-json-1.1", "DynamoDB_20120810.DescribeTable", "dynamodb", "{}", "DescribeTable"},
+		// Query
+		{"POST", "/", "application/x-www-form-urlencoded; charset=utf-8", "", "sqs", "Action=CreateQueue&Version=2012-11-05", "CreateQueue"},
+		{"POST", "/", "application/x-www-form-urlencoded; charset=utf-8", "", "ec2", "Action=DescribeVpcs&Version=2016-11-15", "DescribeVpcs"},
+		// REST-XML
+		{"PUT", "/tf-bucket", "", "", "s3", "", "CreateBucket"},
+		{"HEAD", "/tf-bucket", "", "", "s3", "", "HeadBucket"},
+		{"GET", "/tf-bucket?tagging=", "", "", "s3", "", "GetBucketTagging"},
+		{"DELETE", "/tf-bucket?cors=", "", "", "s3", "", "DeleteBucketCors"},
+		{"GET", "/tf-bucket?versions=", "", "", "s3", "", "ListObjectVersions"},
+		{"PUT", "/tf-bucket/some/key", "", "", "s3", "", "PutObject"},
+		{"GET", "/tf-bucket/some/key?tagging=", "", "", "s3", "", "GetObjectTagging"},
+		// REST-JSON
+		{"GET", "/restapis/abc123", "", "", "apigateway", "", "GET /restapis/abc123"},
+	}
+
+	for i, tc := range cases {
+		r := httptest.NewRequest(tc.Method, tc.Url, nil)
+		r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=mock-access-key/20171017/us-west-2/"+tc.Service+"/aws4_request, SignedHeaders=host, Signature=0")
+		if tc.ContentType != "" {
+			r.Header.Set("Content-Type", tc.ContentType)
+		}
+		if tc.Target != "" {
+			r.Header.Set("X-Amz-Target", tc.Target)
+		}
+
+		if service, region := awsMockRequestScope(r); service != tc.Service || region != "us-west-2" {
+			t.Fatalf("%d: bad scope: %s, %s", i, service, region)
+		}
+		if op := awsMockRequestOperation(r, []byte(tc.Body)); op != tc.Operation {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Operation, op)
+		}
+	}
+}
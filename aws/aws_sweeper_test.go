@@ -3,11 +3,29 @@ package aws
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
+// sweeperNamePrefixes are the prefixes of the names and Name tags given to
+// the infrastructure created by the acceptance tests
+var sweeperNamePrefixes = []string{
+	"tf-acc",
+	"tf_acc",
+	"tf-test",
+	"tf_test",
+	"terraform-test",
+	"terraform_test",
+	"testAcc",
+	"TestAcc",
+}
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
@@ -35,3 +53,73 @@ func sharedClientForRegion(region string) (interface{}, error) {
 
 	return client, nil
 }
+
+// hasSweeperNamePrefix returns whether the name is one given by the
+// acceptance tests
+func hasSweeperNamePrefix(name string) bool {
+	for _, prefix := range sweeperNamePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sweeperEc2NameTagFilter returns the filter matching the EC2 resources with
+// a Name tag given by the acceptance tests
+func sweeperEc2NameTagFilter() *ec2.Filter {
+	values := make([]*string, 0, len(sweeperNamePrefixes))
+	for _, prefix := range sweeperNamePrefixes {
+		values = append(values, aws.String(prefix+"*"))
+	}
+	return &ec2.Filter{
+		Name:   aws.String("tag:Name"),
+		Values: values,
+	}
+}
+
+// sweeperEc2NameTag returns the value of the Name tag of an EC2 resource
+func sweeperEc2NameTag(tags []*ec2.Tag) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == "Name" {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
+// sweeperVpcIds returns the IDs of the VPCs created by the acceptance tests,
+// the EC2 resources inside of them being swept along with them
+func sweeperVpcIds(conn *ec2.EC2) (map[string]bool, error) {
+	resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{sweeperEc2NameTagFilter()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error describing VPCs in Sweeper: %s", err)
+	}
+
+	ids := make(map[string]bool, len(resp.Vpcs))
+	for _, vpc := range resp.Vpcs {
+		ids[*vpc.VpcId] = true
+	}
+	return ids, nil
+}
+
+// testSweepResource destroys a resource with its own Delete function, so it
+// waits for the deletion the same way as terraform destroy, using the default
+// timeouts of the resource. The attributes are the ones read by the Delete
+// function.
+func testSweepResource(r *schema.Resource, id string, attributes map[string]string, client interface{}) error {
+	state := &terraform.InstanceState{
+		ID:         id,
+		Attributes: attributes,
+	}
+	if r.Timeouts != nil {
+		if err := r.Timeouts.StateEncode(state); err != nil {
+			return err
+		}
+	}
+
+	_, err := r.Apply(state, &terraform.InstanceDiff{Destroy: true}, client)
+	return err
+}
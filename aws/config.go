@@ -12,6 +12,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
@@ -76,39 +77,56 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+// AssumeRoleConfig is one hop of the chain of roles assumed by the provider.
+// Each role is assumed with the credentials of the previous one, the first
+// one with the credentials found in the environment.
+type AssumeRoleConfig struct {
+	RoleARN         string
+	SessionName     string
+	ExternalID      string
+	Policy          string
+	DurationSeconds int
+
+	// SerialNumber and TokenCode identify the MFA device and its current
+	// code, for roles requiring MFA to be assumed.
+	SerialNumber string
+	TokenCode    string
+
+	// WebIdentityTokenFile is the path of an OIDC token used to assume the
+	// role with AssumeRoleWithWebIdentity, without any other credentials.
+	// It is only valid for the first role of the chain.
+	WebIdentityTokenFile string
+}
+
 type Config struct {
-	AccessKey     string
-	SecretKey     string
-	CredsFilename string
-	Profile       string
-	Token         string
-	Region        string
-	MaxRetries    int
-
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AccessKey      string
+	SecretKey      string
+	CredsFilename  string
+	ConfigFilename string
+	Profile        string
+	Token          string
+	Region         string
+	MaxRetries     int
+	MaxBackoff     time.Duration
+
+	// Throttling holds the rate limit and retry settings of the services,
+	// by service name.
+	Throttling map[string]*ServiceThrottling
+
+	AssumeRoles []*AssumeRoleConfig
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	RdsEndpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	Insecure                 bool
+	DefaultTags          map[string]string
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+
+	Endpoints map[string]string
+	Insecure  bool
+
+	// ApiTraceFile is the path of the file the API requests are traced to
+	ApiTraceFile string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -116,6 +134,10 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+
+	// tokenBuckets holds the rate limits of the services, by service name,
+	// so that all the clients of a service share its limit.
+	tokenBuckets map[string]*tokenBucket
 }
 
 type AWSClient struct {
@@ -156,6 +178,11 @@ type AWSClient struct {
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	dnsSuffix             string
+	defaultTags           map[string]string
+	ignoreTagKeys         []string
+	ignoreTagKeyPrefixes  []string
+	apiTraceResource      func(resource string) *AWSClient
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
@@ -189,17 +216,11 @@ func (c *AWSClient) DynamoDB() *dynamodb.DynamoDB {
 }
 
 func (c *AWSClient) IsGovCloud() bool {
-	if c.region == "us-gov-west-1" {
-		return true
-	}
-	return false
+	return c.partition == endpoints.AwsUsGovPartitionID
 }
 
 func (c *AWSClient) IsChinaCloud() bool {
-	if c.region == "cn-north-1" {
-		return true
-	}
-	return false
+	return c.partition == endpoints.AwsCnPartitionID
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -220,6 +241,11 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.partition = regionPartition(c.Region)
+	client.dnsSuffix = regionDnsSuffix(c.Region)
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagKeys = c.IgnoreTagKeys
+	client.ignoreTagKeyPrefixes = c.IgnoreTagKeyPrefixes
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -273,35 +299,34 @@ func (c *Config) Client() (interface{}, error) {
 		sess.Handlers.UnmarshalError.PushFrontNamed(debugAuthFailure)
 	}
 
-	// This restriction should only be used for Route53 sessions.
-	// Other resources that have restrictions should allow the API to fail, rather
-	// than Terraform abstracting the region for the user. This can lead to breaking
-	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1")})
-
-	// Some services have user-configurable endpoints
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-
-	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
-
-	// These two services need to be set up early so we can check on AccountID
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(sess)
+	if c.ApiTraceFile != "" {
+		trace, err := openApiTrace(c.ApiTraceFile)
+		if err != nil {
+			return nil, err
+		}
+		trace.addHandlers(&sess.Handlers)
+		log.Printf("[INFO] Tracing AWS API requests to %s", c.ApiTraceFile)
+
+		// Copies of the client tagging their requests with the resource
+		// making them are handed to the resource functions, see
+		// apiTraceWrapResource.
+		client.apiTraceResource = func(resource string) *AWSClient {
+			traced := client
+			traced.apiTraceResource = nil
+
+			addHandlers := func(handlers *request.Handlers) {
+				handlers.Build.PushFrontNamed(apiTraceResourceHandler(resource))
+			}
+			for _, svc := range awsServiceClients {
+				svc.Copy(&traced, addHandlers)
+			}
+			return &traced
+		}
+	}
+
+	for _, svc := range awsServiceClients {
+		svc.New(&client, c.serviceSession(sess, svc))
+	}
 
 	if !c.SkipCredsValidation {
 		err = c.ValidateCredentials(client.stsconn)
@@ -323,8 +348,6 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, authErr
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
-
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
 		if err != nil {
@@ -336,86 +359,6 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.acmconn = acm.New(sess)
-	client.apigateway = apigateway.New(sess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(sess)
-	client.ecsconn = ecs.New(sess)
-	client.efsconn = efs.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(sess)
-	client.firehoseconn = firehose.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(sess)
-	client.lightsailconn = lightsail.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sesConn = ses.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == kinesis.ErrCodeLimitExceededException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
 	return &client, nil
 }
 
@@ -431,29 +374,8 @@ func hasEc2Classic(platforms []string) bool {
 // ValidateRegion returns an error if the configured region is not a
 // valid aws region and nil otherwise.
 func (c *Config) ValidateRegion() error {
-	var regions = []string{
-		"ap-northeast-1",
-		"ap-northeast-2",
-		"ap-south-1",
-		"ap-southeast-1",
-		"ap-southeast-2",
-		"ca-central-1",
-		"cn-north-1",
-		"eu-central-1",
-		"eu-west-1",
-		"eu-west-2",
-		"sa-east-1",
-		"us-east-1",
-		"us-east-2",
-		"us-gov-west-1",
-		"us-west-1",
-		"us-west-2",
-	}
-
-	for _, valid := range regions {
-		if c.Region == valid {
-			return nil
-		}
+	if isKnownRegion(c.Region) {
+		return nil
 	}
 	return fmt.Errorf("Not a valid region: %s", c.Region)
 }
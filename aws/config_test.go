@@ -14,6 +14,7 @@ import (
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestGetSupportedEC2Platforms(t *testing.T) {
@@ -41,10 +42,79 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigClient_endpoints(t *testing.T) {
+	config := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipRegionValidation:    true,
+		SkipRequestingAccountId: true,
+		SkipMetadataApiCheck:    true,
+		Endpoints: map[string]string{
+			"elb":     "http://elb.example.com",
+			"lambda":  "http://lambda.example.com",
+			"route53": "http://route53.example.com",
+		},
+	}
+
+	raw, err := config.Client()
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+	client := raw.(*AWSClient)
+
+	cases := []struct {
+		Name        string
+		Endpoint    string
+		ExpectedURL string
+	}{
+		{"lambda", client.lambdaconn.Endpoint, "http://lambda.example.com"},
+		{"elb", client.elbconn.Endpoint, "http://elb.example.com"},
+		{"elbv2", client.elbv2conn.Endpoint, "http://elb.example.com"},
+		{"route53", client.r53conn.Endpoint, "http://route53.example.com"},
+		{"sqs", client.sqsconn.Endpoint, "https://sqs.us-west-2.amazonaws.com"},
+	}
+
+	for _, tc := range cases {
+		if tc.Endpoint != tc.ExpectedURL {
+			t.Fatalf("%s: expected endpoint %q, got %q", tc.Name, tc.ExpectedURL, tc.Endpoint)
+		}
+	}
+
+	if region := aws.StringValue(client.r53conn.Config.Region); region != "us-east-1" {
+		t.Fatalf("route53: expected region %q, got %q", "us-east-1", region)
+	}
+
+	for _, svc := range awsServiceClients {
+		if _, ok := endpointsSchema().Elem.(*schema.Resource).Schema[svc.Name]; !ok {
+			t.Fatalf("%s: missing from the endpoints block", svc.Name)
+		}
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
-	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	ts := getMockedAwsApiServer(svcName, endpoints)
+
+	sc := awsCredentials.NewStaticCredentials("accessKey", "secretKey", "")
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:                   sc,
+		Region:                        aws.String("us-east-1"),
+		Endpoint:                      aws.String(ts.URL),
+		CredentialsChainVerboseErrors: aws.Bool(true),
+	})
+
+	return ts.Close, sess, err
+}
+
+// getMockedAwsApiServer establishes a httptest server responding to the
+// requests of the endpoints, for clients configured by the test itself
+func getMockedAwsApiServer(svcName string, endpoints []*awsMockEndpoint) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		buf := new(bytes.Buffer)
 		buf.ReadFrom(r.Body)
 		requestBody := buf.String()
@@ -70,17 +140,6 @@ func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(
 		w.WriteHeader(400)
 		return
 	}))
-
-	sc := awsCredentials.NewStaticCredentials("accessKey", "secretKey", "")
-
-	sess, err := session.NewSession(&aws.Config{
-		Credentials:                   sc,
-		Region:                        aws.String("us-east-1"),
-		Endpoint:                      aws.String(ts.URL),
-		CredentialsChainVerboseErrors: aws.Bool(true),
-	})
-
-	return ts.Close, sess, err
 }
 
 type awsMockEndpoint struct {
@@ -35,6 +35,11 @@ func testAccCheckAwsPartition(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Incorrect Partition: expected %q, got %q", expected, rs.Primary.Attributes["partition"])
 		}
 
+		expected = testAccProvider.Meta().(*AWSClient).dnsSuffix
+		if rs.Primary.Attributes["dns_suffix"] != expected {
+			return fmt.Errorf("Incorrect DNS suffix: expected %q, got %q", expected, rs.Primary.Attributes["dns_suffix"])
+		}
+
 		return nil
 	}
 }
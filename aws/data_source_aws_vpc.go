@@ -20,6 +20,27 @@ func dataSourceAwsVpc() *schema.Resource {
 				Computed: true,
 			},
 
+			"cidr_block_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"dhcp_options_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -131,6 +152,9 @@ func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*vpc.VpcId)
 	d.Set("id", vpc.VpcId)
 	d.Set("cidr_block", vpc.CidrBlock)
+	if err := d.Set("cidr_block_associations", flattenVpcCidrBlockAssociations(vpc.CidrBlockAssociationSet)); err != nil {
+		return fmt.Errorf("Error setting cidr_block_associations: %s", err)
+	}
 	d.Set("dhcp_options_id", vpc.DhcpOptionsId)
 	d.Set("instance_tenancy", vpc.InstanceTenancy)
 	d.Set("default", vpc.IsDefault)
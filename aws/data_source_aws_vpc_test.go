@@ -87,6 +87,9 @@ func testAccDataSourceAwsVpcCheck(name, cidr, tag string) resource.TestCheckFunc
 		if attr["tags.Name"] != tag {
 			return fmt.Errorf("bad Name tag %s", attr["tags.Name"])
 		}
+		if attr["cidr_block_associations.#"] != "1" || attr["cidr_block_associations.0.cidr_block"] != cidr {
+			return fmt.Errorf("bad cidr_block_associations, expected only the primary block %s", cidr)
+		}
 
 		return nil
 	}
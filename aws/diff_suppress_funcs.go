@@ -60,6 +60,16 @@ func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) boo
 	return jsonBytesEqual(ob.Bytes(), nb.Bytes())
 }
 
+func suppressEquivalentEcsContainerDefinitionsDiffs(k, old, new string, d *schema.ResourceData) bool {
+	networkMode := d.Get("network_mode").(string)
+	equivalent, err := ecsContainerDefinitionsAreEquivalent(old, new, networkMode)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
 func suppressOpenIdURL(k, old, new string, d *schema.ResourceData) bool {
 	oldUrl, err := url.Parse(old)
 	if err != nil {
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// ecsContainerDefinitionsAreEquivalent compares the container definitions
+// given in the configuration with the ones returned by the ECS API, which
+// fills in the defaults of the parameters left out and returns empty lists
+// for the unset ones. Some defaults depend on the network mode of the task
+// definition.
+func ecsContainerDefinitionsAreEquivalent(def1, def2, networkMode string) (bool, error) {
+	canonical1, err := canonicalEcsContainerDefinitions(def1, networkMode)
+	if err != nil {
+		return false, err
+	}
+
+	canonical2, err := canonicalEcsContainerDefinitions(def2, networkMode)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(canonical1, canonical2), nil
+}
+
+func canonicalEcsContainerDefinitions(raw, networkMode string) ([]byte, error) {
+	var definitions []*ecs.ContainerDefinition
+	if err := json.Unmarshal([]byte(raw), &definitions); err != nil {
+		return nil, err
+	}
+
+	for _, def := range definitions {
+		reduceEcsContainerDefinition(def, networkMode)
+	}
+
+	return jsonutil.BuildJSON(definitions)
+}
+
+// reduceEcsContainerDefinition removes the parameters of the container
+// definition which are set to their default value.
+func reduceEcsContainerDefinition(def *ecs.ContainerDefinition, networkMode string) {
+	if aws.Int64Value(def.Cpu) == 0 {
+		def.Cpu = nil
+	}
+	if def.Essential != nil && *def.Essential {
+		def.Essential = nil
+	}
+	for _, pm := range def.PortMappings {
+		if aws.StringValue(pm.Protocol) == ecs.TransportProtocolTcp {
+			pm.Protocol = nil
+		}
+		if aws.Int64Value(pm.HostPort) == 0 {
+			pm.HostPort = nil
+		}
+		// In host mode the host port is the container port
+		if networkMode == ecs.NetworkModeHost && aws.Int64Value(pm.HostPort) == aws.Int64Value(pm.ContainerPort) {
+			pm.HostPort = nil
+		}
+	}
+
+	// The order of the environment variables is not kept by the API
+	sort.Slice(def.Environment, func(i, j int) bool {
+		return aws.StringValue(def.Environment[i].Name) < aws.StringValue(def.Environment[j].Name)
+	})
+
+	v := reflect.ValueOf(def).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() == reflect.Slice && !f.IsNil() && f.Len() == 0 {
+			f.Set(reflect.Zero(f.Type()))
+		}
+	}
+}
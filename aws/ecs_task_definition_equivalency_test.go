@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestEcsContainerDefinitionsAreEquivalent(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Def1        string
+		Def2        string
+		NetworkMode string
+		Equivalent  bool
+	}{
+		{
+			Name: "defaults filled in by the API",
+			Def1: `[{"name": "wordpress", "image": "wordpress", "memory": 128,
+				"portMappings": [{"containerPort": 80}],
+				"environment": [{"name": "B", "value": "2"}, {"name": "A", "value": "1"}]}]`,
+			Def2: `[{"cpu": 0, "environment": [{"name": "A", "value": "1"}, {"name": "B", "value": "2"}],
+				"essential": true, "image": "wordpress", "memory": 128, "mountPoints": [], "name": "wordpress",
+				"portMappings": [{"containerPort": 80, "hostPort": 0, "protocol": "tcp"}], "volumesFrom": []}]`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different image",
+			Def1:       `[{"name": "wordpress", "image": "wordpress:4.8", "memory": 128}]`,
+			Def2:       `[{"name": "wordpress", "image": "wordpress:4.9", "memory": 128, "essential": true}]`,
+			Equivalent: false,
+		},
+		{
+			Name:       "non essential container",
+			Def1:       `[{"name": "wordpress", "image": "wordpress", "memory": 128, "essential": false}]`,
+			Def2:       `[{"name": "wordpress", "image": "wordpress", "memory": 128, "essential": true}]`,
+			Equivalent: false,
+		},
+		{
+			Name: "host port filled in by the API in host mode",
+			Def1: `[{"name": "wordpress", "image": "wordpress", "memory": 128,
+				"portMappings": [{"containerPort": 80}]}]`,
+			Def2: `[{"name": "wordpress", "image": "wordpress", "memory": 128, "essential": true,
+				"portMappings": [{"containerPort": 80, "hostPort": 80, "protocol": "tcp"}]}]`,
+			NetworkMode: "host",
+			Equivalent:  true,
+		},
+		{
+			Name: "host port equal to the container port in bridge mode",
+			Def1: `[{"name": "wordpress", "image": "wordpress", "memory": 128,
+				"portMappings": [{"containerPort": 80}]}]`,
+			Def2: `[{"name": "wordpress", "image": "wordpress", "memory": 128, "essential": true,
+				"portMappings": [{"containerPort": 80, "hostPort": 80, "protocol": "tcp"}]}]`,
+			NetworkMode: "bridge",
+			Equivalent:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		equivalent, err := ecsContainerDefinitionsAreEquivalent(tc.Def1, tc.Def2, tc.NetworkMode)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+		if equivalent != tc.Equivalent {
+			t.Fatalf("%s: expected equivalent to be %t", tc.Name, tc.Equivalent)
+		}
+	}
+
+	if _, err := ecsContainerDefinitionsAreEquivalent(`[{"name": `, `[]`, ""); err == nil {
+		t.Fatal("Expected an error for invalid JSON")
+	}
+}
+
+func TestFlattenEcsContainerDefinitions(t *testing.T) {
+	definitions := []*ecs.ContainerDefinition{
+		{
+			Name:      aws.String("wordpress"),
+			Image:     aws.String("wordpress"),
+			Memory:    aws.Int64(128),
+			Essential: aws.Bool(true),
+			PortMappings: []*ecs.PortMapping{
+				{ContainerPort: aws.Int64(80), HostPort: aws.Int64(8080), Protocol: aws.String("tcp")},
+			},
+		},
+	}
+
+	expected := `[{"essential":true,"image":"wordpress","memory":128,"name":"wordpress",` +
+		`"portMappings":[{"containerPort":80,"hostPort":8080,"protocol":"tcp"}]}]`
+
+	flattened, err := flattenEcsContainerDefinitions(definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flattened != expected {
+		t.Fatalf("Expected %s, got %s", expected, flattened)
+	}
+
+	equivalent, err := ecsContainerDefinitionsAreEquivalent(flattened, `[{"name": "wordpress", "image": "wordpress",
+		"memory": 128, "portMappings": [{"containerPort": 80, "hostPort": 8080}]}]`, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equivalent {
+		t.Fatal("Expected the flattened container definitions to be equivalent to the configured ones")
+	}
+}
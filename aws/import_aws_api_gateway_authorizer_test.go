@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayAuthorizer_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_authorizer.test"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayAuthorizerConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "rest_api_id", "id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayAuthorizerDestroy,
+		Steps:        steps,
+	})
+}
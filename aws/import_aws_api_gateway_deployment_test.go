@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayDeployment_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_deployment.test"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayDeploymentConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "rest_api_id", "id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDeploymentDestroy,
+		Steps:        steps,
+	})
+}
+
+func TestAccAWSAPIGatewayDeployment_importStageName(t *testing.T) {
+	resourceName := "aws_api_gateway_deployment.test"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayDeploymentConfigSecondStage,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "rest_api_id", "id", "stage_name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDeploymentDestroy,
+		Steps:        steps,
+	})
+}
+
+const testAccAWSAPIGatewayDeploymentConfigSecondStage = testAccAWSAPIGatewayDeploymentConfig + `
+resource "aws_api_gateway_stage" "second" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  deployment_id = "${aws_api_gateway_deployment.test.id}"
+  stage_name = "second"
+}
+`
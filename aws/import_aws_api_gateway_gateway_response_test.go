@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayGatewayResponse_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_gateway_response.test"
+	rName := acctest.RandString(10)
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayGatewayResponseConfig(rName),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "rest_api_id", "response_type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayGatewayResponseDestroy,
+		Steps:        steps,
+	})
+}
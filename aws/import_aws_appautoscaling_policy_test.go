@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAppautoScalingPolicy_importBasic(t *testing.T) {
+	randClusterName := fmt.Sprintf("cluster%s", acctest.RandString(10))
+	randPolicyName := fmt.Sprintf("terraform-test-foobar-%s", acctest.RandString(5))
+	resourceName := "aws_appautoscaling_policy.foobar_simple"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAppautoscalingPolicyConfig(randClusterName, randPolicyName),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "service_namespace", "resource_id", "scalable_dimension", "name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingPolicyDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAppautoScalingTarget_importBasic(t *testing.T) {
+	randClusterName := fmt.Sprintf("cluster-%s", acctest.RandString(10))
+	resourceName := "aws_appautoscaling_target.bar"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAppautoscalingTargetConfig(randClusterName),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "service_namespace", "resource_id", "scalable_dimension")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingTargetDestroy,
+		Steps:        steps,
+	})
+}
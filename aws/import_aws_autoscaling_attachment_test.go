@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAwsAutoscalingAttachment_importBasic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_autoscaling_attachment.asg_attachment_foo"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAutoscalingAttachment_alb_associated(rInt),
+		},
+
+		{
+			ResourceName: resourceName,
+			ImportState:  true,
+		},
+	}
+	steps[0].Check = resource.ComposeTestCheckFunc(
+		testAccCheckResourceImportStateId(&steps[1], resourceName, "autoscaling_group_name", "alb_target_group_arn"),
+		testAccCheckResourceImportStateAttributes(&steps[1], resourceName, "autoscaling_group_name", "alb_target_group_arn"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps:     steps,
+	})
+}
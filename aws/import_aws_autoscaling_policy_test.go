@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAutoscalingPolicy_importBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform-test-foobar-%s", acctest.RandString(5))
+	resourceName := "aws_autoscaling_policy.foobar_simple"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAutoscalingPolicyConfig(name),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "autoscaling_group_name", "name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAutoscalingSchedule_importBasic(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
+	resourceName := "aws_autoscaling_schedule.foobar"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAutoscalingScheduleConfig_recurrence(rName),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "autoscaling_group_name", "scheduled_action_name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingScheduleDestroy,
+		Steps:        steps,
+	})
+}
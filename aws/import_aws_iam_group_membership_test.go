@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSGroupMembership_importBasic(t *testing.T) {
+	rString := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resourceName := "aws_iam_group_membership.team"
+
+	steps := []resource.TestStep{
+		{
+			Config: fmt.Sprintf(testAccAWSGroupMemberConfig, rString, rString, rString),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "group", "name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGroupMembershipDestroy,
+		Steps:        steps,
+	})
+}
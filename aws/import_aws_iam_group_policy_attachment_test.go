@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSIamGroupPolicyAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_iam_group_policy_attachment.test-attach"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSGroupPolicyAttachConfig,
+		},
+
+		{
+			ResourceName: resourceName,
+			ImportState:  true,
+		},
+	}
+	steps[0].Check = resource.ComposeTestCheckFunc(
+		testAccCheckResourceImportStateId(&steps[1], resourceName, "group", "policy_arn"),
+		testAccCheckResourceImportStateAttributes(&steps[1], resourceName, "group", "policy_arn"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGroupPolicyAttachmentDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSRolePolicyAttachment_importBasic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resourceName := "aws_iam_role_policy_attachment.test-attach"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSRolePolicyAttachConfig(rInt),
+		},
+
+		{
+			ResourceName: resourceName,
+			ImportState:  true,
+		},
+	}
+	steps[0].Check = resource.ComposeTestCheckFunc(
+		testAccCheckResourceImportStateId(&steps[1], resourceName, "role", "policy_arn"),
+		testAccCheckResourceImportStateAttributes(&steps[1], resourceName, "role", "policy_arn"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRolePolicyAttachmentDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSUserPolicyAttachment_importBasic(t *testing.T) {
+	rName := acctest.RandString(10)
+	policyName := fmt.Sprintf("test-policy-%s", acctest.RandString(10))
+
+	resourceName := "aws_iam_user_policy_attachment.test-attach"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSUserPolicyAttachConfig(rName, policyName),
+		},
+
+		{
+			ResourceName: resourceName,
+			ImportState:  true,
+		},
+	}
+	steps[0].Check = resource.ComposeTestCheckFunc(
+		testAccCheckResourceImportStateId(&steps[1], resourceName, "user", "policy_arn"),
+		testAccCheckResourceImportStateAttributes(&steps[1], resourceName, "user", "policy_arn"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserPolicyAttachmentDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSUserSSHKey_importBasic(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "aws_iam_user_ssh_key.user"
+
+	steps := []resource.TestStep{
+		{
+			Config: fmt.Sprintf(testAccAWSSSHKeyConfig_sshEncoding, ri),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			// The key comment is not returned by IAM
+			ImportStateVerifyIgnore: []string{"public_key"},
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "username", "id", "encoding")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserSSHKeyDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLambdaPermission_importBasic(t *testing.T) {
+	resourceName := "aws_lambda_permission.allow_cloudwatch"
+	rName := fmt.Sprintf("tf_iam_%d", acctest.RandInt())
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSLambdaPermissionConfig(rName),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckAWSLambdaPermissionImportStateId(&steps[1], resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLambdaPermissionDestroy,
+		Steps:        steps,
+	})
+}
+
+func TestAccAWSLambdaPermission_importWithQualifier(t *testing.T) {
+	resourceName := "aws_lambda_permission.with_qualifier"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSLambdaPermissionConfig_withQualifier,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckAWSLambdaPermissionImportStateId(&steps[1], resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLambdaPermissionDestroy,
+		Steps:        steps,
+	})
+}
+
+func TestAccAWSLambdaPermission_importWithS3(t *testing.T) {
+	resourceName := "aws_lambda_permission.with_s3"
+
+	steps := []resource.TestStep{
+		{
+			Config: fmt.Sprintf(testAccAWSLambdaPermissionConfig_withS3_tpl, acctest.RandInt()),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckAWSLambdaPermissionImportStateId(&steps[1], resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLambdaPermissionDestroy,
+		Steps:        steps,
+	})
+}
+
+// testAccCheckAWSLambdaPermissionImportStateId sets the ID of the import step
+// to the function name of the permission, with its qualifier if any, and its
+// statement ID.
+func testAccCheckAWSLambdaPermissionImportStateId(step *resource.TestStep, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		attrs := rs.Primary.Attributes
+
+		functionName := attrs["function_name"]
+		if v := attrs["qualifier"]; v != "" {
+			functionName = functionName + ":" + v
+		}
+
+		step.ImportStateId = functionName + "/" + attrs["statement_id"]
+		return nil
+	}
+}
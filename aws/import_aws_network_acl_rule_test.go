@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSNetworkAclRule_importBasic(t *testing.T) {
+	resourceName := "aws_network_acl_rule.baz"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSNetworkAclRuleBasicConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateIdSeparator(&steps[1], resourceName, ":", "network_acl_id", "rule_number", "protocol", "egress")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkAclRuleDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSOpsworksPermissionImportBasic(t *testing.T) {
+	sName := fmt.Sprintf("tf-ops-perm-%d", acctest.RandInt())
+
+	resourceName := "aws_opsworks_permission.tf-acc-perm"
+
+	steps := []resource.TestStep{
+		resource.TestStep{
+			Config: testAccAwsOpsworksPermissionCreate(sName, "true", "false", "deploy"),
+		},
+
+		resource.TestStep{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "stack_id", "user_arn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOpsworksPermissionDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSOpsworksRailsAppLayerImportBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-%d", acctest.RandInt())
+	config := testAccAwsOpsworksRailsAppLayerConfigVpcCreate(name)
+
+	resourceName := "aws_opsworks_rails_app_layer.tf-acc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOpsworksRailsAppLayerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: config,
+			},
+
+			resource.TestStep{
+				Config:            config,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSOpsworksRailsAppLayerImportOtherType(t *testing.T) {
+	name := fmt.Sprintf("tf-%d", acctest.RandInt())
+	config := testAccAwsOpsworksRailsAppLayerConfigVpcCreate(name)
+
+	steps := []resource.TestStep{
+		resource.TestStep{
+			Config: config,
+		},
+
+		resource.TestStep{
+			Config:       config,
+			ResourceName: "aws_opsworks_custom_layer.tf-acc",
+			ImportState:  true,
+			ExpectError:  regexp.MustCompile(`is of type "rails-app", expected "custom"`),
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], "aws_opsworks_rails_app_layer.tf-acc", "id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOpsworksRailsAppLayerDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSOpsworksRdsDbInstanceImportBasic(t *testing.T) {
+	sName := fmt.Sprintf("test-db-instance-%d", acctest.RandInt())
+
+	resourceName := "aws_opsworks_rds_db_instance.tf-acc-opsworks-db"
+
+	steps := []resource.TestStep{
+		resource.TestStep{
+			Config: testAccAwsOpsworksRdsDbInstance(sName, "foo", "barbarbarbar"),
+		},
+
+		resource.TestStep{
+			ResourceName:            resourceName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"db_password"},
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "stack_id", "rds_db_instance_arn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOpsworksRdsDbDestroy,
+		Steps:        steps,
+	})
+}
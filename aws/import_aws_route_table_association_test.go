@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSRouteTableAssociation_importBasic(t *testing.T) {
+	resourceName := "aws_route_table_association.foo"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccRouteTableAssociationConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "subnet_id", "route_table_id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRouteTableAssociationDestroy,
+		Steps:        steps,
+	})
+}
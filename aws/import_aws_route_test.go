@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSRoute_importBasic(t *testing.T) {
+	resourceName := "aws_route.bar"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSRouteBasicConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateIdSeparator(&steps[1], resourceName, "_", "route_table_id", "destination_cidr_block")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps:        steps,
+	})
+}
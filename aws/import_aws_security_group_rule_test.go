@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSecurityGroupRule_importIngress(t *testing.T) {
+	resourceName := "aws_security_group_rule.ingress_1"
+	rInt := acctest.RandInt()
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSSecurityGroupRuleIngressConfig(rInt),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckAWSSecurityGroupRuleImportStateId(&steps[1], resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps:        steps,
+	})
+}
+
+func TestAccAWSSecurityGroupRule_importIpv6(t *testing.T) {
+	resourceName := "aws_security_group_rule.ingress_1"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSSecurityGroupRuleIngress_ipv6Config,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckAWSSecurityGroupRuleImportStateId(&steps[1], resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps:        steps,
+	})
+}
+
+func TestAccAWSSecurityGroupRule_importSelf(t *testing.T) {
+	resourceName := "aws_security_group_rule.self"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSSecurityGroupRuleConfigSelfReference,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckAWSSecurityGroupRuleImportStateId(&steps[1], resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps:        steps,
+	})
+}
+
+func TestAccAWSSecurityGroupRule_importPrefixList(t *testing.T) {
+	resourceName := "aws_security_group_rule.egress_1"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSSecurityGroupRulePrefixListEgressConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckAWSSecurityGroupRuleImportStateId(&steps[1], resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps:        steps,
+	})
+}
+
+// testAccCheckAWSSecurityGroupRuleImportStateId sets the ID of the import
+// step to the import ID of the rule in state.
+func testAccCheckAWSSecurityGroupRuleImportStateId(step *resource.TestStep, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		attrs := rs.Primary.Attributes
+
+		parts := []string{
+			attrs["security_group_id"],
+			attrs["type"],
+			attrs["protocol"],
+			attrs["from_port"],
+			attrs["to_port"],
+		}
+
+		var sources []string
+		for k, v := range attrs {
+			for _, list := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+				if strings.HasPrefix(k, list+".") && k != list+".#" {
+					sources = append(sources, v)
+				}
+			}
+		}
+		sort.Strings(sources)
+		parts = append(parts, sources...)
+
+		if attrs["self"] == "true" {
+			parts = append(parts, "self")
+		} else if v := attrs["source_security_group_id"]; v != "" {
+			parts = append(parts, v)
+		}
+
+		step.ImportStateId = strings.Join(parts, "_")
+		return nil
+	}
+}
@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSSSMMaintenanceWindowTarget_importBasic(t *testing.T) {
+	resourceName := "aws_ssm_maintenance_window_target.target"
+	name := acctest.RandString(10)
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSSSMMaintenanceWindowTargetBasicConfig(name),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccCheckResourceImportStateId(&steps[1], resourceName, "window_id", "id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowTargetDestroy,
+		Steps:        steps,
+	})
+}
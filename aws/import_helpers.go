@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// splitImportId splits the ID given to terraform import into the parts of
+// the format, e.g. REST-API-ID/RESOURCE-ID. The parts are separated by
+// slashes, except for the last one which may contain slashes itself.
+func splitImportId(id, format string) ([]string, error) {
+	n := strings.Count(format, "/") + 1
+
+	parts := strings.SplitN(id, "/", n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected %s", id, format)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("Unexpected format of ID (%q), expected %s", id, format)
+		}
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSplitImportId(t *testing.T) {
+	cases := []struct {
+		Id       string
+		Format   string
+		Expected []string
+	}{
+		{"abc123/def456", "REST-API-ID/RESOURCE-ID", []string{"abc123", "def456"}},
+		{"abc123/def456/GET", "REST-API-ID/RESOURCE-ID/HTTP-METHOD", []string{"abc123", "def456", "GET"}},
+		{"example.com/v1/users", "DOMAIN-NAME/BASE-PATH", []string{"example.com", "v1/users"}},
+		{"abc123", "REST-API-ID/RESOURCE-ID", nil},
+		{"abc123/", "REST-API-ID/RESOURCE-ID", nil},
+		{"/def456/GET", "REST-API-ID/RESOURCE-ID/HTTP-METHOD", nil},
+	}
+
+	for _, tc := range cases {
+		parts, err := splitImportId(tc.Id, tc.Format)
+		if tc.Expected == nil {
+			if err == nil {
+				t.Fatalf("%q: expected an error, got %q", tc.Id, parts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tc.Id, err)
+		}
+		if !reflect.DeepEqual(parts, tc.Expected) {
+			t.Fatalf("%q: expected %q, got %q", tc.Id, tc.Expected, parts)
+		}
+	}
+}
+
+// testAccCheckResourceImportStateId sets the ID of the import step to the
+// attributes of the resource in state joined by slashes, for resources
+// imported by a composite ID. The import step has to run after the step
+// holding the check.
+func testAccCheckResourceImportStateId(step *resource.TestStep, name string, attrs ...string) resource.TestCheckFunc {
+	return testAccCheckResourceImportStateIdSeparator(step, name, "/", attrs...)
+}
+
+// testAccCheckResourceImportStateIdSeparator is like
+// testAccCheckResourceImportStateId for composite IDs whose parts are joined
+// by another separator than a slash.
+func testAccCheckResourceImportStateIdSeparator(step *resource.TestStep, name, sep string, attrs ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		parts := make([]string, 0, len(attrs))
+		for _, attr := range attrs {
+			if attr == "id" {
+				parts = append(parts, rs.Primary.ID)
+				continue
+			}
+			v, ok := rs.Primary.Attributes[attr]
+			if !ok {
+				return fmt.Errorf("Attribute %s of %s not found in state", attr, name)
+			}
+			parts = append(parts, v)
+		}
+
+		step.ImportStateId = strings.Join(parts, sep)
+		return nil
+	}
+}
+
+// testAccCheckResourceImportStateAttributes sets the check of the import step
+// to compare the attributes of the imported resource with the ones of the
+// resource in state, for resources whose imported ID differs from the ID
+// given on creation and which cannot be verified by ImportStateVerify.
+func testAccCheckResourceImportStateAttributes(step *resource.TestStep, name string, attrs ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		expected := make(map[string]string, len(attrs))
+		for _, attr := range attrs {
+			expected[attr] = rs.Primary.Attributes[attr]
+		}
+
+		step.ImportStateCheck = func(states []*terraform.InstanceState) error {
+			if len(states) != 1 {
+				return fmt.Errorf("Expected 1 imported resource, got %d", len(states))
+			}
+			for attr, v := range expected {
+				if states[0].Attributes[attr] != v {
+					return fmt.Errorf("Expected imported %s to be %q, got %q", attr, v, states[0].Attributes[attr])
+				}
+			}
+			return nil
+		}
+		return nil
+	}
+}
@@ -252,7 +252,10 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				client := meta.(*AWSClient).opsworksconn
+				return lt.Import(d, client)
+			},
 		},
 
 		Schema: resourceSchema,
@@ -333,6 +336,28 @@ func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWo
 	return nil
 }
 
+// Import checks that the layer being imported is of the type of the resource,
+// as all the layer types share the same API.
+func (lt *opsworksLayerType) Import(d *schema.ResourceData, client *opsworks.OpsWorks) ([]*schema.ResourceData, error) {
+	resp, err := client.DescribeLayers(&opsworks.DescribeLayersInput{
+		LayerIds: []*string{
+			aws.String(d.Id()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Layers) == 0 {
+		return nil, fmt.Errorf("OpsWorks layer %s not found", d.Id())
+	}
+
+	if layerType := aws.StringValue(resp.Layers[0].Type); layerType != lt.TypeName {
+		return nil, fmt.Errorf("OpsWorks layer %s is of type %q, expected %q", d.Id(), layerType, lt.TypeName)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func (lt *opsworksLayerType) Create(d *schema.ResourceData, client *opsworks.OpsWorks) error {
 
 	req := &opsworks.CreateLayerInput{
@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// partitionForRegion returns the partition the region belongs to, as known
+// by the endpoints metadata of the SDK. Unknown regions matching the naming
+// pattern of a partition are reported to belong to it.
+func partitionForRegion(region string) (endpoints.Partition, bool) {
+	return endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+}
+
+// isKnownRegion reports whether the region is listed by one of the
+// partitions in the endpoints metadata of the SDK.
+func isKnownRegion(region string) bool {
+	for _, p := range endpoints.DefaultPartitions() {
+		if _, ok := p.Regions()[region]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// regionPartition returns the partition ID of the region, e.g. aws-cn for
+// China regions. It defaults to the standard aws partition.
+func regionPartition(region string) string {
+	if p, ok := partitionForRegion(region); ok {
+		return p.ID()
+	}
+	return endpoints.AwsPartitionID
+}
+
+// regionDnsSuffix returns the DNS suffix of the service endpoints in the
+// region, e.g. amazonaws.com.cn for China regions.
+func regionDnsSuffix(region string) string {
+	p, ok := partitionForRegion(region)
+	if !ok {
+		p = endpoints.AwsPartition()
+	}
+
+	// The partition does not expose its DNS suffix, so it is taken from the
+	// default {service}.{region}.{dnsSuffix} hostname of the partition.
+	e, err := p.EndpointFor("service", region, endpoints.ResolveUnknownServiceOption)
+	if err != nil {
+		return "amazonaws.com"
+	}
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return "amazonaws.com"
+	}
+
+	return strings.TrimPrefix(u.Host, "service."+region+".")
+}
+
+// arnString returns the ARN of a resource from its components. The region
+// and account ID are empty for global resources.
+func arnString(partition, service, region, accountId, resource string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: accountId,
+		Resource:  resource,
+	}.String()
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestRegionPartition(t *testing.T) {
+	cases := []struct {
+		Region    string
+		Partition string
+		DnsSuffix string
+		Known     bool
+	}{
+		{"us-west-2", "aws", "amazonaws.com", true},
+		{"eu-west-2", "aws", "amazonaws.com", true},
+		{"cn-north-1", "aws-cn", "amazonaws.com.cn", true},
+		{"us-gov-west-1", "aws-us-gov", "amazonaws.com", true},
+		{"eu-north-9", "aws", "amazonaws.com", false},
+		{"non-existent-1", "aws", "amazonaws.com", false},
+	}
+
+	for _, tc := range cases {
+		if p := regionPartition(tc.Region); p != tc.Partition {
+			t.Fatalf("%s: expected partition %q, got %q", tc.Region, tc.Partition, p)
+		}
+		if s := regionDnsSuffix(tc.Region); s != tc.DnsSuffix {
+			t.Fatalf("%s: expected DNS suffix %q, got %q", tc.Region, tc.DnsSuffix, s)
+		}
+		if k := isKnownRegion(tc.Region); k != tc.Known {
+			t.Fatalf("%s: expected known to be %t, got %t", tc.Region, tc.Known, k)
+		}
+	}
+}
+
+func TestArnString(t *testing.T) {
+	cases := []struct {
+		Partition, Service, Region, AccountId, Resource string
+		Expected                                        string
+	}{
+		{"aws", "s3", "", "", "my-bucket", "arn:aws:s3:::my-bucket"},
+		{"aws-cn", "rds", "cn-north-1", "123456789012", "db:my-db", "arn:aws-cn:rds:cn-north-1:123456789012:db:my-db"},
+		{"aws-us-gov", "iam", "", "123456789012", "root", "arn:aws-us-gov:iam::123456789012:root"},
+	}
+
+	for _, tc := range cases {
+		arn := arnString(tc.Partition, tc.Service, tc.Region, tc.AccountId, tc.Resource)
+		if arn != tc.Expected {
+			t.Fatalf("Expected ARN %q, got %q", tc.Expected, arn)
+		}
+	}
+}
+
+func TestAWSClientIsChinaCloud(t *testing.T) {
+	for region, expected := range map[string]bool{
+		"cn-north-1":    true,
+		"us-east-1":     false,
+		"us-gov-west-1": false,
+	} {
+		client := &AWSClient{partition: regionPartition(region)}
+		if client.IsChinaCloud() != expected {
+			t.Fatalf("%s: expected IsChinaCloud to be %t", region, expected)
+		}
+		if client.IsGovCloud() != (region == "us-gov-west-1") {
+			t.Fatalf("%s: unexpected IsGovCloud", region)
+		}
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +20,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -50,6 +52,13 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["shared_credentials_file"],
 			},
 
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["shared_config_file"],
+			},
+
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -75,6 +84,14 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  descriptions["max_backoff"],
+				ValidateFunc: validateDurationString,
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -109,6 +126,19 @@ func Provider() terraform.ResourceProvider {
 
 			"endpoints": endpointsSchema(),
 
+			"throttling": throttlingSchema(),
+
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
+
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_API_TRACE_FILE", ""),
+				Description: descriptions["api_trace_file"],
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -372,6 +402,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_lambda_alias":                                        resourceAwsLambdaAlias(),
 			"aws_lambda_permission":                                   resourceAwsLambdaPermission(),
 			"aws_launch_configuration":                                resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                                     resourceAwsLaunchTemplate(),
 			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
@@ -481,6 +512,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc":                                                 resourceAwsVpc(),
 			"aws_vpc_endpoint":                                        resourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_route_table_association":                resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_ipv4_cidr_block_association":                     resourceAwsVpcIpv4CidrBlockAssociation(),
 			"aws_vpn_connection":                                      resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                                resourceAwsVpnConnectionRoute(),
 			"aws_vpn_gateway":                                         resourceAwsVpnGateway(),
@@ -499,6 +531,15 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, r := range provider.ResourcesMap {
+		apiTraceWrapResource(name, r)
+	}
+	for name, r := range provider.DataSourcesMap {
+		apiTraceWrapResource("data."+name, r)
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -520,6 +561,10 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_config_file": "The path to the shared config file, holding the\n" +
+			"credential_process and source_profile settings of profiles. If not set\n" +
+			"this defaults to ~/.aws/config.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -527,15 +572,25 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
-		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"max_backoff": "The maximum delay between the retries of an AWS API request,\n" +
+			"as a duration such as 30s. The delay increases exponentially up to\n" +
+			"this value. If not set, the default delays of the AWS SDK are used.",
 
-		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"throttling": "Configuration block with the client-side rate limit and the\n" +
+			"extra retryable error codes of the API requests to a service.",
 
-		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"throttling_service": "The name of the service, as used in the `endpoints` block.",
 
-		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"throttling_requests_per_second": "The maximum sustained rate of API requests\n" +
+			"to the service, including retries.",
 
-		"devicefarm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"throttling_burst": "The number of API requests to the service which can be\n" +
+			"made at once above the sustained rate. Defaults to 1.",
+
+		"throttling_retryable_error_codes": "Error codes of the service which are retried\n" +
+			"in addition to the throttling and transient errors retried by default.",
+
+		"endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
 
 		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to dynamodb-local.",
@@ -543,21 +598,8 @@ func init() {
 		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to kinesalite.",
 
-		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"iam_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"ec2_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"elb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"api_trace_file": "The path of a file every AWS API request is recorded to as a\n" +
+			"JSON line, followed by a summary by operation when the provider exits.",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
@@ -582,6 +624,19 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"default_tags": "Configuration block with tags that are applied to every resource\n" +
+			"managed by this provider that supports tags. Tags set on a resource\n" +
+			"take precedence over these defaults.",
+
+		"default_tags_tags": "The tags to apply to every taggable resource by default.",
+
+		"ignore_tags": "Configuration block with tag keys that are managed outside of\n" +
+			"Terraform. Matching tags are never shown in diffs and never removed.",
+
+		"ignore_tags_keys": "Tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Tag key prefixes to ignore across all resources.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -593,6 +648,17 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. If omitted," +
+			" the default duration of the AssumeRole call is used.",
+
+		"assume_role_serial_number": "The identification number of the MFA device required to" +
+			" assume the role.",
+
+		"assume_role_token_code": "The current code of the MFA device required to assume the role.",
+
+		"assume_role_web_identity_token_file": "The path of a file containing an OIDC token used to" +
+			" assume the role with AssumeRoleWithWebIdentity. Only valid for the first role assumed.",
 	}
 }
 
@@ -620,42 +686,113 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
+	// Set ConfigFilename, expanding home directory
+	configPath, err := homedir.Expand(d.Get("shared_config_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.ConfigFilename = configPath
+
+	for _, assumeRoleI := range d.Get("assume_role").([]interface{}) {
+		if assumeRoleI == nil {
+			continue
+		}
+		assumeRole := assumeRoleI.(map[string]interface{})
+		if assumeRole["role_arn"].(string) == "" {
+			continue
+		}
 
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
+		role := &AssumeRoleConfig{
+			RoleARN:              assumeRole["role_arn"].(string),
+			SessionName:          assumeRole["session_name"].(string),
+			ExternalID:           assumeRole["external_id"].(string),
+			Policy:               assumeRole["policy"].(string),
+			DurationSeconds:      assumeRole["duration_seconds"].(int),
+			SerialNumber:         assumeRole["serial_number"].(string),
+			TokenCode:            assumeRole["token_code"].(string),
+			WebIdentityTokenFile: assumeRole["web_identity_token_file"].(string),
 		}
+		config.AssumeRoles = append(config.AssumeRoles, role)
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
-	} else {
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, Duration: %ds, SerialNumber: %q, WebIdentityTokenFile: %q)",
+			role.RoleARN, role.SessionName, role.ExternalID, role.Policy, role.DurationSeconds, role.SerialNumber, role.WebIdentityTokenFile)
+	}
+	if len(config.AssumeRoles) == 0 {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	defaultTagsList := d.Get("default_tags").([]interface{})
+	if len(defaultTagsList) == 1 && defaultTagsList[0] != nil {
+		defaultTags := defaultTagsList[0].(map[string]interface{})
+		config.DefaultTags = make(map[string]string)
+		for k, v := range defaultTags["tags"].(map[string]interface{}) {
+			config.DefaultTags[k] = v.(string)
+		}
+
+		log.Printf("[INFO] default_tags configuration set: %#v", config.DefaultTags)
+	}
+
+	ignoreTagsList := d.Get("ignore_tags").([]interface{})
+	if len(ignoreTagsList) == 1 && ignoreTagsList[0] != nil {
+		ignoreTags := ignoreTagsList[0].(map[string]interface{})
+		for _, v := range ignoreTags["keys"].(*schema.Set).List() {
+			config.IgnoreTagKeys = append(config.IgnoreTagKeys, v.(string))
+		}
+		for _, v := range ignoreTags["key_prefixes"].(*schema.Set).List() {
+			config.IgnoreTagKeyPrefixes = append(config.IgnoreTagKeyPrefixes, v.(string))
+		}
+
+		log.Printf("[INFO] ignore_tags configuration set: (Keys: %q, KeyPrefixes: %q)",
+			config.IgnoreTagKeys, config.IgnoreTagKeyPrefixes)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
+		config.Endpoints = make(map[string]string)
+		for _, svc := range awsServiceClients {
+			config.Endpoints[svc.Name] = endpoints[svc.Name].(string)
+		}
+	}
+
+	// Set ApiTraceFile, expanding home directory
+	apiTracePath, err := homedir.Expand(d.Get("api_trace_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.ApiTraceFile = apiTracePath
+
+	if v := d.Get("max_backoff").(string); v != "" {
+		// The duration was checked by validateDurationString
+		config.MaxBackoff, _ = time.ParseDuration(v)
+	}
+
+	for _, throttlingI := range d.Get("throttling").([]interface{}) {
+		if throttlingI == nil {
+			continue
+		}
+		throttling := throttlingI.(map[string]interface{})
+
+		t := &ServiceThrottling{
+			RequestsPerSecond: throttling["requests_per_second"].(float64),
+			Burst:             throttling["burst"].(int),
+		}
+		for _, v := range throttling["retryable_error_codes"].(*schema.Set).List() {
+			t.RetryableErrorCodes = append(t.RetryableErrorCodes, v.(string))
+		}
+
+		service := throttling["service"].(string)
+		if config.Throttling == nil {
+			config.Throttling = make(map[string]*ServiceThrottling)
+		}
+		if _, ok := config.Throttling[service]; ok {
+			return nil, fmt.Errorf("throttling: service %q is configured more than once", service)
+		}
+		config.Throttling[service] = t
+
+		log.Printf("[INFO] throttling configuration set for %s: (RequestsPerSecond: %g, Burst: %d, RetryableErrorCodes: %q)",
+			service, t.RequestsPerSecond, t.Burst, t.RetryableErrorCodes)
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -674,9 +811,8 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -702,111 +838,149 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
-			},
-		},
-	}
-}
 
-func endpointsSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
-				},
-				"cloudwatchevents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
-				},
-				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
-				},
-				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
-				},
-				"devicefarm": {
-					Type:        schema.TypeString,
+				"duration_seconds": {
+					Type:        schema.TypeInt,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
+					Description: descriptions["assume_role_duration_seconds"],
 				},
-				"dynamodb": {
+
+				"serial_number": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
+					Description: descriptions["assume_role_serial_number"],
 				},
-				"iam": {
+
+				"token_code": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+					Sensitive:   true,
+					Description: descriptions["assume_role_token_code"],
 				},
 
-				"ec2": {
+				"web_identity_token_file": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+					Description: descriptions["assume_role_web_identity_token_file"],
 				},
+			},
+		},
+	}
+}
 
-				"elb": {
-					Type:        schema.TypeString,
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
+					Description: descriptions["default_tags_tags"],
 				},
-				"kinesis": {
-					Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
 				},
-				"kms": {
-					Type:        schema.TypeString,
+
+				"key_prefixes": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
 				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
+			},
+		},
+	}
+}
+
+func throttlingSchema() *schema.Schema {
+	services := make([]string, 0, len(awsServiceClients))
+	for _, svc := range awsServiceClients {
+		services = append(services, svc.Name)
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["throttling"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  descriptions["throttling_service"],
+					ValidateFunc: validation.StringInSlice(services, false),
 				},
-				"s3": {
-					Type:        schema.TypeString,
+
+				"requests_per_second": {
+					Type:        schema.TypeFloat,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
+					Description: descriptions["throttling_requests_per_second"],
 				},
-				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
+
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["throttling_burst"],
+					ValidateFunc: validation.IntAtLeast(0),
 				},
-				"sqs": {
-					Type:        schema.TypeString,
+
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["throttling_retryable_error_codes"],
 				},
 			},
 		},
+	}
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, svc := range awsServiceClients {
+		description, ok := descriptions[svc.Name+"_endpoint"]
+		if !ok {
+			description = descriptions["endpoint"]
+		}
+
+		endpointsAttributes[svc.Name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: description,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
+		},
 		Set: endpointsToHash,
 	}
 }
@@ -814,21 +988,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, svc := range awsServiceClients {
+		buf.WriteString(fmt.Sprintf("%s-", m[svc.Name].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -156,7 +156,7 @@ func resourceAwsAlbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	elbOpts := &elbv2.CreateLoadBalancerInput{
 		Name: aws.String(name),
-		Tags: tagsFromMapELBv2(d.Get("tags").(map[string]interface{})),
+		Tags: tagsFromMapELBv2(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{}))),
 	}
 
 	if scheme, ok := d.GetOk("internal"); ok && scheme.(bool) {
@@ -252,7 +252,7 @@ func resourceAwsAlbUpdate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
 	if !d.IsNewResource() {
-		if err := setElbV2Tags(elbconn, d); err != nil {
+		if err := setElbV2Tags(elbconn, d, meta); err != nil {
 			return errwrap.Wrapf("Error Modifying Tags on ALB: {{err}}", err)
 		}
 	}
@@ -511,7 +511,7 @@ func flattenAwsAlbResource(d *schema.ResourceData, meta interface{}, alb *elbv2.
 	if len(respTags.TagDescriptions) > 0 {
 		et = respTags.TagDescriptions[0].Tags
 	}
-	d.Set("tags", tagsToMapELBv2(et))
+	d.Set("tags", tagsForState(d, meta, tagsToMapELBv2(et)))
 
 	attributesResp, err := elbconn.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{
 		LoadBalancerArn: aws.String(d.Id()),
@@ -252,7 +252,7 @@ func resourceAwsAlbTargetGroupRead(d *schema.ResourceData, meta interface{}) err
 func resourceAwsAlbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
-	if err := setElbV2Tags(elbconn, d); err != nil {
+	if err := setElbV2Tags(elbconn, d, meta); err != nil {
 		return errwrap.Wrapf("Error Modifying Tags on ALB Target Group: {{err}}", err)
 	}
 
@@ -533,7 +533,7 @@ func flattenAwsAlbTargetGroupResource(d *schema.ResourceData, meta interface{},
 	}
 	for _, t := range tagsResp.TagDescriptions {
 		if *t.ResourceArn == d.Id() {
-			if err := d.Set("tags", tagsToMapELBv2(t.Tags)); err != nil {
+			if err := d.Set("tags", tagsForState(d, meta, tagsToMapELBv2(t.Tags))); err != nil {
 				return err
 			}
 		}
@@ -228,7 +228,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ebs_block_device", ebsBlockDevs)
 	d.Set("ephemeral_block_device", ephemeralBlockDevs)
 
-	d.Set("tags", tagsToMap(image.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMap(image.Tags)))
 
 	return nil
 }
@@ -238,7 +238,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if err := setTags(client, d); err != nil {
+	if err := setTags(client, d, meta); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
@@ -18,6 +18,10 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 		Update: resourceAwsApiGatewayAuthorizerUpdate,
 		Delete: resourceAwsApiGatewayAuthorizerDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayAuthorizerImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"authorizer_uri": &schema.Schema{
 				Type:     schema.TypeString,
@@ -210,3 +214,15 @@ func resourceAwsApiGatewayAuthorizerDelete(d *schema.ResourceData, meta interfac
 
 	return nil
 }
+
+func resourceAwsApiGatewayAuthorizerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/AUTHORIZER-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 		Update: resourceAwsApiGatewayDeploymentUpdate,
 		Delete: resourceAwsApiGatewayDeploymentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayDeploymentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -198,3 +203,62 @@ func resourceAwsApiGatewayDeploymentDelete(d *schema.ResourceData, meta interfac
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayDeploymentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).apigateway
+
+	format := "REST-API-ID/DEPLOYMENT-ID"
+	if strings.Count(d.Id(), "/") == 2 {
+		format += "/STAGE-NAME"
+	}
+	idParts, err := splitImportId(d.Id(), format)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+
+	// The stage created along with the deployment isn't part of the
+	// deployment itself, so it's either given or looked up among the
+	// stages using it
+	var stage *apigateway.Stage
+	if len(idParts) == 3 {
+		stage, err = conn.GetStage(&apigateway.GetStageInput{
+			RestApiId: aws.String(idParts[0]),
+			StageName: aws.String(idParts[2]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error reading API Gateway Stage %s: %s", idParts[2], err)
+		}
+		if aws.StringValue(stage.DeploymentId) != d.Id() {
+			return nil, fmt.Errorf("API Gateway Stage %s doesn't use Deployment %s", idParts[2], d.Id())
+		}
+	} else {
+		out, err := conn.GetStages(&apigateway.GetStagesInput{
+			RestApiId:    aws.String(idParts[0]),
+			DeploymentId: aws.String(idParts[1]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error reading stages of API Gateway Deployment %s: %s", d.Id(), err)
+		}
+
+		switch len(out.Item) {
+		case 0:
+			log.Printf("[DEBUG] API Gateway Deployment %s isn't used by any stage, leaving stage_name empty", d.Id())
+		case 1:
+			stage = out.Item[0]
+		default:
+			return nil, fmt.Errorf("API Gateway Deployment %s is used by %d stages, "+
+				"import it with REST-API-ID/DEPLOYMENT-ID/STAGE-NAME", d.Id(), len(out.Item))
+		}
+	}
+
+	if stage != nil {
+		d.Set("stage_name", stage.StageName)
+		d.Set("stage_description", stage.Description)
+		d.Set("variables", aws.StringValueMap(stage.Variables))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
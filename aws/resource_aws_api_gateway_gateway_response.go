@@ -19,6 +19,10 @@ func resourceAwsApiGatewayGatewayResponse() *schema.Resource {
 		Update: resourceAwsApiGatewayGatewayResponsePut,
 		Delete: resourceAwsApiGatewayGatewayResponseDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayGatewayResponseImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -143,3 +147,16 @@ func resourceAwsApiGatewayGatewayResponseDelete(d *schema.ResourceData, meta int
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayGatewayResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/RESPONSE-TYPE")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("response_type", idParts[1])
+	d.SetId(fmt.Sprintf("aggr-%s-%s", idParts[0], idParts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -21,6 +21,10 @@ func resourceAwsApiGatewayIntegration() *schema.Resource {
 		Update: resourceAwsApiGatewayIntegrationUpdate,
 		Delete: resourceAwsApiGatewayIntegrationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayIntegrationImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -231,6 +235,10 @@ func resourceAwsApiGatewayIntegrationRead(d *schema.ResourceData, meta interface
 	d.Set("request_parameters_in_json", aws.StringValueMap(integration.RequestParameters))
 	d.Set("passthrough_behavior", integration.PassthroughBehavior)
 
+	if integration.HttpMethod != nil {
+		d.Set("integration_http_method", integration.HttpMethod)
+	}
+
 	if integration.Uri != nil {
 		d.Set("uri", integration.Uri)
 	}
@@ -412,3 +420,17 @@ func resourceAwsApiGatewayIntegrationDelete(d *schema.ResourceData, meta interfa
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayIntegrationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/RESOURCE-ID/HTTP-METHOD")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("resource_id", idParts[1])
+	d.Set("http_method", idParts[2])
+	d.SetId(fmt.Sprintf("agi-%s-%s-%s", idParts[0], idParts[1], idParts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -20,6 +20,10 @@ func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
 		Update: resourceAwsApiGatewayIntegrationResponseCreate,
 		Delete: resourceAwsApiGatewayIntegrationResponseDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayIntegrationResponseImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -148,10 +152,11 @@ func resourceAwsApiGatewayIntegrationResponseRead(d *schema.ResourceData, meta i
 	log.Printf("[DEBUG] Received API Gateway Integration Response: %s", integrationResponse)
 
 	d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", d.Get("rest_api_id").(string), d.Get("resource_id").(string), d.Get("http_method").(string), d.Get("status_code").(string)))
-	d.Set("response_templates", integrationResponse.ResponseTemplates)
+	d.Set("response_templates", aws.StringValueMap(integrationResponse.ResponseTemplates))
 	d.Set("selection_pattern", integrationResponse.SelectionPattern)
 	d.Set("response_parameters", aws.StringValueMap(integrationResponse.ResponseParameters))
 	d.Set("response_parameters_in_json", aws.StringValueMap(integrationResponse.ResponseParameters))
+	d.Set("content_handling", integrationResponse.ContentHandling)
 	return nil
 }
 
@@ -182,3 +187,18 @@ func resourceAwsApiGatewayIntegrationResponseDelete(d *schema.ResourceData, meta
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayIntegrationResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("resource_id", idParts[1])
+	d.Set("http_method", idParts[2])
+	d.Set("status_code", idParts[3])
+	d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", idParts[0], idParts[1], idParts[2], idParts[3]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -19,6 +19,10 @@ func resourceAwsApiGatewayModel() *schema.Resource {
 		Update: resourceAwsApiGatewayModelUpdate,
 		Delete: resourceAwsApiGatewayModelDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayModelImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -166,3 +170,15 @@ func resourceAwsApiGatewayModelDelete(d *schema.ResourceData, meta interface{})
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayModelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/MODEL-NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("name", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -17,6 +17,10 @@ func resourceAwsApiGatewayRequestValidator() *schema.Resource {
 		Update: resourceAwsApiGatewayRequestValidatorUpdate,
 		Delete: resourceAwsApiGatewayRequestValidatorDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayRequestValidatorImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -152,3 +156,15 @@ func resourceAwsApiGatewayRequestValidatorDelete(d *schema.ResourceData, meta in
 
 	return nil
 }
+
+func resourceAwsApiGatewayRequestValidatorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/REQUEST-VALIDATOR-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -19,6 +19,10 @@ func resourceAwsApiGatewayResource() *schema.Resource {
 		Update: resourceAwsApiGatewayResourceUpdate,
 		Delete: resourceAwsApiGatewayResourceDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayResourceImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -147,3 +151,15 @@ func resourceAwsApiGatewayResourceDelete(d *schema.ResourceData, meta interface{
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayResourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/RESOURCE-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
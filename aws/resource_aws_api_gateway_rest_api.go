@@ -20,6 +20,10 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 		Update: resourceAwsApiGatewayRestApiUpdate,
 		Delete: resourceAwsApiGatewayRestApiDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -93,10 +97,6 @@ func resourceAwsApiGatewayRestApiCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if err = resourceAwsApiGatewayRestApiRefreshResources(d, meta); err != nil {
-		return err
-	}
-
 	return resourceAwsApiGatewayRestApiRead(d, meta)
 }
 
@@ -139,6 +139,10 @@ func resourceAwsApiGatewayRestApiRead(d *schema.ResourceData, meta interface{})
 	d.Set("description", api.Description)
 	d.Set("binary_media_types", api.BinaryMediaTypes)
 
+	if err := resourceAwsApiGatewayRestApiRefreshResources(d, meta); err != nil {
+		return err
+	}
+
 	if err := d.Set("created_date", api.CreatedDate.Format(time.RFC3339)); err != nil {
 		log.Printf("[DEBUG] Error setting created_date: %s", err)
 	}
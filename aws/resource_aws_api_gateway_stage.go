@@ -19,6 +19,10 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 		Update: resourceAwsApiGatewayStageUpdate,
 		Delete: resourceAwsApiGatewayStageDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayStageImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cache_cluster_enabled": {
 				Type:     schema.TypeBool,
@@ -340,3 +344,16 @@ func resourceAwsApiGatewayStageDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+func resourceAwsApiGatewayStageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "REST-API-ID/STAGE-NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("stage_name", idParts[1])
+	d.SetId(fmt.Sprintf("ags-%s-%s", idParts[0], idParts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
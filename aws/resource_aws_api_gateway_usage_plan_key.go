@@ -18,6 +18,10 @@ func resourceAwsApiGatewayUsagePlanKey() *schema.Resource {
 		Read:   resourceAwsApiGatewayUsagePlanKeyRead,
 		Delete: resourceAwsApiGatewayUsagePlanKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayUsagePlanKeyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_id": {
 				Type:     schema.TypeString,
@@ -86,6 +90,7 @@ func resourceAwsApiGatewayUsagePlanKeyRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	d.Set("key_type", up.Type)
 	d.Set("name", up.Name)
 	d.Set("value", up.Value)
 
@@ -112,3 +117,16 @@ func resourceAwsApiGatewayUsagePlanKeyDelete(d *schema.ResourceData, meta interf
 		return resource.NonRetryableError(err)
 	})
 }
+
+func resourceAwsApiGatewayUsagePlanKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := splitImportId(d.Id(), "USAGE-PLAN-ID/USAGE-PLAN-KEY-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("usage_plan_id", idParts[0])
+	d.Set("key_id", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -18,6 +18,9 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 		Read:   resourceAwsAppautoscalingPolicyRead,
 		Update: resourceAwsAppautoscalingPolicyUpdate,
 		Delete: resourceAwsAppautoscalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -479,3 +482,18 @@ func resourceAwsAppautoscalingAdjustmentHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+func resourceAwsAppautoscalingPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	namespace, resourceId, dimension, name, err := splitAppautoscalingImportId(d.Id())
+	if err != nil || name == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SERVICE-NAMESPACE/RESOURCE-ID/SCALABLE-DIMENSION/POLICY-NAME", d.Id())
+	}
+
+	d.Set("service_namespace", namespace)
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", dimension)
+	d.Set("name", name)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
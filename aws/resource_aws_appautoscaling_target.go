@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -18,6 +19,9 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 		Create: resourceAwsAppautoscalingTargetCreate,
 		Read:   resourceAwsAppautoscalingTargetRead,
 		Delete: resourceAwsAppautoscalingTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"max_capacity": {
@@ -188,3 +192,43 @@ func getAwsAppautoscalingTarget(
 
 	return nil, nil
 }
+
+func resourceAwsAppautoscalingTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	namespace, resourceId, dimension, rest, err := splitAppautoscalingImportId(d.Id())
+	if err != nil || rest != "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SERVICE-NAMESPACE/RESOURCE-ID/SCALABLE-DIMENSION", d.Id())
+	}
+
+	d.Set("service_namespace", namespace)
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", dimension)
+	d.SetId(resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// splitAppautoscalingImportId splits the ID given to terraform import into
+// the service namespace, the resource ID and the scalable dimension, followed
+// by the rest of the ID if any. The resource ID contains slashes itself, e.g.
+// service/default/web, so it ends right before the scalable dimension, which
+// is prefixed by the service namespace, e.g. ecs:service:DesiredCount.
+func splitAppautoscalingImportId(id string) (namespace, resourceId, dimension, rest string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 3 || parts[0] == "" {
+		err = fmt.Errorf("Unexpected format of ID (%q)", id)
+		return
+	}
+	namespace = parts[0]
+
+	for i := 2; i < len(parts); i++ {
+		if strings.HasPrefix(parts[i], namespace+":") {
+			resourceId = strings.Join(parts[1:i], "/")
+			dimension = parts[i]
+			rest = strings.Join(parts[i+1:], "/")
+			return
+		}
+	}
+
+	err = fmt.Errorf("Unexpected format of ID (%q), no scalable dimension of the %s namespace found", id, namespace)
+	return
+}
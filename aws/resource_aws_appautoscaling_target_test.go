@@ -88,6 +88,50 @@ func TestAccAWSAppautoScalingTarget_emrCluster(t *testing.T) {
 	})
 }
 
+func TestSplitAppautoscalingImportId(t *testing.T) {
+	cases := []struct {
+		Id         string
+		Namespace  string
+		ResourceId string
+		Dimension  string
+		Rest       string
+		Error      bool
+	}{
+		{
+			Id:         "ecs/service/default/web/ecs:service:DesiredCount",
+			Namespace:  "ecs",
+			ResourceId: "service/default/web",
+			Dimension:  "ecs:service:DesiredCount",
+		},
+		{
+			Id:         "elasticmapreduce/instancegroup/j-2EEZNYKUA1NTV/ig-1791Y4E1L8YI0/elasticmapreduce:instancegroup:InstanceCount/scale-out",
+			Namespace:  "elasticmapreduce",
+			ResourceId: "instancegroup/j-2EEZNYKUA1NTV/ig-1791Y4E1L8YI0",
+			Dimension:  "elasticmapreduce:instancegroup:InstanceCount",
+			Rest:       "scale-out",
+		},
+		{Id: "ecs/service/default/web", Error: true},
+		{Id: "ecs/ecs:service:DesiredCount", Error: true},
+	}
+
+	for _, tc := range cases {
+		namespace, resourceId, dimension, rest, err := splitAppautoscalingImportId(tc.Id)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("%q: expected an error", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tc.Id, err)
+		}
+		if namespace != tc.Namespace || resourceId != tc.ResourceId || dimension != tc.Dimension || rest != tc.Rest {
+			t.Fatalf("%q: expected %q, %q, %q, %q, got %q, %q, %q, %q", tc.Id,
+				tc.Namespace, tc.ResourceId, tc.Dimension, tc.Rest, namespace, resourceId, dimension, rest)
+		}
+	}
+}
+
 func testAccCheckAWSAppautoscalingTargetDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
 
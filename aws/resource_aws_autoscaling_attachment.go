@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -16,6 +17,9 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 		Create: resourceAwsAutoscalingAttachmentCreate,
 		Read:   resourceAwsAutoscalingAttachmentRead,
 		Delete: resourceAwsAutoscalingAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"autoscaling_group_name": {
@@ -155,3 +159,21 @@ func resourceAwsAutoscalingAttachmentDelete(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+func resourceAwsAutoscalingAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "ASG-NAME/ELB-NAME-OR-TARGET-GROUP-ARN")
+	if err != nil {
+		return nil, err
+	}
+	asgName := parts[0]
+
+	d.Set("autoscaling_group_name", asgName)
+	if strings.HasPrefix(parts[1], "arn:") {
+		d.Set("alb_target_group_arn", parts[1])
+	} else {
+		d.Set("elb", parts[1])
+	}
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", asgName)))
+
+	return []*schema.ResourceData{d}, nil
+}
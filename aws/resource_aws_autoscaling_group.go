@@ -63,10 +63,13 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			},
 
 			"launch_configuration": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"launch_template"},
 			},
 
+			"launch_template": launchTemplateSpecificationSchema([]string{"launch_configuration"}, false),
+
 			"desired_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -319,9 +322,20 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 
 	createOpts := autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
-		LaunchConfigurationName:          aws.String(d.Get("launch_configuration").(string)),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
 	}
+
+	if v, ok := d.GetOk("launch_configuration"); ok {
+		createOpts.LaunchConfigurationName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("launch_template"); ok {
+		launchTemplate, err := expandAutoScalingLaunchTemplateSpecification(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+		createOpts.LaunchTemplate = launchTemplate
+	} else {
+		return fmt.Errorf("One of launch_configuration or launch_template must be set for autoscaling group %s", asgName)
+	}
 	updateOpts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(asgName),
 	}
@@ -471,6 +485,9 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
 	d.Set("launch_configuration", g.LaunchConfigurationName)
+	if err := d.Set("launch_template", flattenAutoScalingLaunchTemplateSpecification(g.LaunchTemplate, d.Get("launch_template").([]interface{}))); err != nil {
+		return fmt.Errorf("Error setting launch_template: %s", err)
+	}
 	d.Set("load_balancers", flattenStringList(g.LoadBalancerNames))
 
 	if err := d.Set("suspended_processes", flattenAsgSuspendedProcesses(g.SuspendedProcesses)); err != nil {
@@ -523,7 +540,12 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		for _, t := range g.Tags {
+			if !tagKeyIgnored(meta, aws.StringValue(t.Key)) {
+				tagList = append(tagList, t)
+			}
+		}
+		d.Set("tag", autoscalingTagDescriptionsToSlice(tagList))
 	}
 
 	if len(*g.VPCZoneIdentifier) > 0 {
@@ -574,7 +596,19 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("launch_configuration") {
-		opts.LaunchConfigurationName = aws.String(d.Get("launch_configuration").(string))
+		if v, ok := d.GetOk("launch_configuration"); ok {
+			opts.LaunchConfigurationName = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("launch_template") {
+		if v, ok := d.GetOk("launch_template"); ok {
+			launchTemplate, err := expandAutoScalingLaunchTemplateSpecification(v.([]interface{}))
+			if err != nil {
+				return err
+			}
+			opts.LaunchTemplate = launchTemplate
+		}
 	}
 
 	if d.HasChange("min_size") {
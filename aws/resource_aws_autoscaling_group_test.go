@@ -378,6 +378,57 @@ func TestAccAWSAutoScalingGroup_withPlacementGroup(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_launchTemplate(t *testing.T) {
+	var group autoscaling.Group
+
+	randName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate(randName, "$Default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "launch_configuration", ""),
+					resource.TestCheckResourceAttrPair(
+						"aws_autoscaling_group.bar", "launch_template.0.id",
+						"aws_launch_template.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "launch_template.0.version", "$Default"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate(randName, "$Latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "launch_template.0.version", "$Latest"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplateByName(randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					func(*terraform.State) error {
+						expected := randName + "-2"
+						if group.LaunchTemplate == nil || aws.StringValue(group.LaunchTemplate.LaunchTemplateName) != expected {
+							return fmt.Errorf("Expected the group to use launch template %s, got %#v", expected, group.LaunchTemplate)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "launch_template.0.name", randName+"-2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoScalingGroup_enablingMetrics(t *testing.T) {
 	var group autoscaling.Group
 	randName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
@@ -1275,6 +1326,59 @@ resource "aws_autoscaling_group" "bar" {
 `, name, name)
 }
 
+func testAccAWSAutoScalingGroupConfig_launchTemplate(name, version string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foobar" {
+  name = "%s"
+  image_id = "ami-21f78e11"
+  instance_type = "t1.micro"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  name = "%s"
+  max_size = 1
+  min_size = 1
+  desired_capacity = 1
+  force_delete = true
+
+  launch_template {
+    id = "${aws_launch_template.foobar.id}"
+    version = "%s"
+  }
+}
+`, name, name, version)
+}
+
+func testAccAWSAutoScalingGroupConfig_launchTemplateByName(name string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foobar" {
+  name = "%[1]s"
+  image_id = "ami-21f78e11"
+  instance_type = "t1.micro"
+}
+
+resource "aws_launch_template" "foobar2" {
+  name = "%[1]s-2"
+  image_id = "ami-21f78e11"
+  instance_type = "t1.micro"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  name = "%[1]s"
+  max_size = 1
+  min_size = 1
+  desired_capacity = 1
+  force_delete = true
+
+  launch_template {
+    name = "${aws_launch_template.foobar2.name}"
+  }
+}
+`, name)
+}
+
 const testAccAWSAutoscalingMetricsCollectionConfig_allMetricsCollected = `
 resource "aws_launch_configuration" "foobar" {
   image_id = "ami-21f78e11"
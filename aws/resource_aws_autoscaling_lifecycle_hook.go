@@ -19,6 +19,9 @@ func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
 		Read:   resourceAwsAutoscalingLifecycleHookRead,
 		Update: resourceAwsAutoscalingLifecycleHookPut,
 		Delete: resourceAwsAutoscalingLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingLifecycleHookImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -192,3 +195,16 @@ func getAwsAutoscalingLifecycleHook(d *schema.ResourceData, meta interface{}) (*
 	// lifecycle hook not found
 	return nil, nil
 }
+
+func resourceAwsAutoscalingLifecycleHookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "ASG-NAME/LIFECYCLE-HOOK-NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("autoscaling_group_name", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
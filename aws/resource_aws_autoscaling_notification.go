@@ -16,6 +16,9 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 		Read:   resourceAwsAutoscalingNotificationRead,
 		Update: resourceAwsAutoscalingNotificationUpdate,
 		Delete: resourceAwsAutoscalingNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingNotificationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"topic_arn": &schema.Schema{
@@ -209,3 +212,11 @@ func convertSetToList(s *schema.Set) (nl []*string) {
 
 	return nl
 }
+
+func resourceAwsAutoscalingNotificationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// With no group names, the notification configurations of all the groups
+	// are read and the ones of the topic are kept.
+	d.Set("topic_arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
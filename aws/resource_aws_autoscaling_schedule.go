@@ -19,6 +19,9 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 		Read:   resourceAwsAutoscalingScheduleRead,
 		Update: resourceAwsAutoscalingScheduleCreate,
 		Delete: resourceAwsAutoscalingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingScheduleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -217,3 +220,16 @@ func resourceAwsASGScheduledActionRetrieve(d *schema.ResourceData, meta interfac
 
 	return actions.ScheduledUpdateGroupActions[0], nil, true
 }
+
+func resourceAwsAutoscalingScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "ASG-NAME/SCHEDULED-ACTION-NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("autoscaling_group_name", parts[0])
+	d.Set("scheduled_action_name", parts[1])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
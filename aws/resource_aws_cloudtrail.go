@@ -217,7 +217,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 		tags = tagsOut.ResourceTagList[0].TagsList
 	}
 
-	if err := d.Set("tags", tagsToMapCloudtrail(tags)); err != nil {
+	if err := d.Set("tags", tagsForState(d, meta, tagsToMapCloudtrail(tags))); err != nil {
 		return err
 	}
 
@@ -285,8 +285,8 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if d.HasChange("tags") {
-		err := setTagsCloudtrail(conn, d)
+	if tagsNeedUpdate(d) {
+		err := setTagsCloudtrail(conn, d, meta)
 		if err != nil {
 			return err
 		}
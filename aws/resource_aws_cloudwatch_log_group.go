@@ -114,7 +114,7 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return err
 		}
-		d.Set("tags", tags)
+		d.Set("tags", tagsForState(d, meta, tags))
 	}
 
 	return nil
@@ -174,55 +174,31 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 
 	restricted := meta.(*AWSClient).IsChinaCloud() || meta.(*AWSClient).IsGovCloud()
 
-	if !restricted && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffCloudWatchTags(o, n)
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags from %s", name)
-			_, err := conn.UntagLogGroup(&cloudwatchlogs.UntagLogGroupInput{
-				LogGroupName: aws.String(name),
-				Tags:         remove,
-			})
-			if err != nil {
+	if !restricted {
+		err := updateKeyValueTags(d, meta, keyValueTagsUpdater{
+			remove: func(tags keyValueTags) error {
+				_, err := conn.UntagLogGroup(&cloudwatchlogs.UntagLogGroupInput{
+					LogGroupName: aws.String(name),
+					Tags:         aws.StringSlice(tags.Keys()),
+				})
 				return err
-			}
-		}
-
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags on %s", name)
-			_, err := conn.TagLogGroup(&cloudwatchlogs.TagLogGroupInput{
-				LogGroupName: aws.String(name),
-				Tags:         create,
-			})
-			if err != nil {
+			},
+			create: func(tags keyValueTags) error {
+				_, err := conn.TagLogGroup(&cloudwatchlogs.TagLogGroupInput{
+					LogGroupName: aws.String(name),
+					Tags:         tags.Pointers(),
+				})
 				return err
-			}
+			},
+		})
+		if err != nil {
+			return err
 		}
 	}
 
 	return resourceAwsCloudWatchLogGroupRead(d, meta)
 }
 
-func diffCloudWatchTags(oldTags map[string]interface{}, newTags map[string]interface{}) (map[string]*string, []*string) {
-	create := make(map[string]*string)
-	for k, v := range newTags {
-		create[k] = aws.String(v.(string))
-	}
-
-	var remove []*string
-	for t, _ := range oldTags {
-		_, ok := create[t]
-		if !ok {
-			remove = append(remove, aws.String(t))
-		}
-	}
-
-	return create, remove
-}
-
 func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 	log.Printf("[INFO] Deleting CloudWatch Log Group: %s", d.Id())
@@ -239,7 +215,7 @@ func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWatchLogs) (map[string]interface{}, error) {
+func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWatchLogs) (map[string]string, error) {
 	tagsOutput, err := conn.ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
 		LogGroupName: aws.String(d.Get("name").(string)),
 	})
@@ -247,14 +223,8 @@ func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWat
 		return nil, errwrap.Wrapf("Error Getting CloudWatch Logs Tag List: {{err}}", err)
 	}
 	if tagsOutput != nil {
-		output := make(map[string]interface{}, len(tagsOutput.Tags))
-
-		for i, v := range tagsOutput.Tags {
-			output[i] = *v
-		}
-
-		return output, nil
+		return newKeyValueTags(tagsOutput.Tags).Map(), nil
 	}
 
-	return make(map[string]interface{}), nil
+	return make(map[string]string), nil
 }
@@ -213,8 +213,8 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.TimeoutInMinutes = aws.Int64(int64(v.(int)))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
+	if v := tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		params.Tags = tagsFromMapCodeBuild(v)
 	}
 
 	var resp *codebuild.CreateProjectOutput
@@ -396,7 +396,7 @@ func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("service_role", project.ServiceRole)
 	d.Set("build_timeout", project.TimeoutInMinutes)
 
-	if err := d.Set("tags", tagsToMapCodeBuild(project.Tags)); err != nil {
+	if err := d.Set("tags", tagsForState(d, meta, tagsToMapCodeBuild(project.Tags))); err != nil {
 		return err
 	}
 
@@ -443,7 +443,7 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 
 	// The documentation clearly says "The replacement set of tags for this build project."
 	// But its a slice of pointers so if not set for every update, they get removed.
-	params.Tags = tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
+	params.Tags = tagsFromMapCodeBuild(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 
 	_, err := conn.UpdateProject(params)
 
@@ -67,7 +67,7 @@ func resourceAwsDbEventSubscription() *schema.Resource {
 func resourceAwsDbEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
 	name := d.Get("name").(string)
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 
 	sourceIdsSet := d.Get("source_ids").(*schema.Set)
 	sourceIds := make([]*string, sourceIdsSet.Len())
@@ -170,7 +170,7 @@ func resourceAwsDbEventSubscriptionRead(d *schema.ResourceData, meta interface{}
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags", tagsForState(d, meta, tagsToMapRDS(dt)))
 	}
 
 	return nil
@@ -265,7 +265,7 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if arn, err := buildRDSEventSubscriptionARN(d.Get("customer_aws_id").(string), d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).region); err == nil {
-		if err := setTagsRDS(rdsconn, d, arn); err != nil {
+		if err := setTagsRDS(rdsconn, d, arn, meta); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -380,6 +380,6 @@ func buildRDSEventSubscriptionARN(customerAwsId, subscriptionId, partition, regi
 	if partition == "" {
 		return "", fmt.Errorf("Unable to construct RDS ARN because of missing AWS partition")
 	}
-	arn := fmt.Sprintf("arn:%s:rds:%s:%s:es:%s", partition, region, customerAwsId, subscriptionId)
+	arn := arnString(partition, "rds", region, customerAwsId, "es:"+subscriptionId)
 	return arn, nil
 }
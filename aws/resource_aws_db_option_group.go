@@ -119,7 +119,7 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 
 func resourceAwsDbOptionGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -211,7 +211,7 @@ func resourceAwsDbOptionGroupRead(d *schema.ResourceData, meta interface{}) erro
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags", tagsForState(d, meta, tagsToMapRDS(dt)))
 	}
 
 	return nil
@@ -285,7 +285,7 @@ func resourceAwsDbOptionGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if arn, err := buildRDSOptionGroupARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := setTagsRDS(rdsconn, d, arn); err != nil {
+		if err := setTagsRDS(rdsconn, d, arn, meta); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -363,6 +363,6 @@ func buildRDSOptionGroupARN(identifier, partition, accountid, region string) (st
 	if accountid == "" {
 		return "", fmt.Errorf("Unable to construct RDS Option Group ARN because of missing AWS Account ID")
 	}
-	arn := fmt.Sprintf("arn:%s:rds:%s:%s:og:%s", partition, region, accountid, identifier)
+	arn := arnString(partition, "rds", region, accountid, "og:"+identifier)
 	return arn, nil
 }
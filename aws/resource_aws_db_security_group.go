@@ -83,7 +83,7 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 
 func resourceAwsDbSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 
 	var err error
 	var errs []error
@@ -197,7 +197,7 @@ func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags", tagsForState(d, meta, tagsToMapRDS(dt)))
 	}
 
 	return nil
@@ -208,7 +208,7 @@ func resourceAwsDbSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 	if arn, err := buildRDSSecurityGroupARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := setTagsRDS(conn, d, arn); err != nil {
+		if err := setTagsRDS(conn, d, arn, meta); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -428,7 +428,7 @@ func buildRDSSecurityGroupARN(identifier, partition, accountid, region string) (
 	if accountid == "" {
 		return "", fmt.Errorf("Unable to construct RDS ARN because of missing AWS Account ID")
 	}
-	arn := fmt.Sprintf("arn:%s:rds:%s:%s:secgrp:%s", partition, region, accountid, identifier)
+	arn := arnString(partition, "rds", region, accountid, "secgrp:"+identifier)
 	return arn, nil
 
 }
@@ -230,7 +230,7 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
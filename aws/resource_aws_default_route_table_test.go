@@ -85,6 +85,32 @@ func TestAccAWSDefaultRouteTable_vpc_endpoint(t *testing.T) {
 	})
 }
 
+func TestAccAWSDefaultRouteTable_defaultTags(t *testing.T) {
+	var v ec2.RouteTable
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_default_route_table.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDefaultRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultRouteTable_defaultTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(
+						"aws_default_route_table.foo", &v),
+					testAccCheckTags(&v.Tags, "Name", "tf-default-route-table-test"),
+					testAccCheckTags(&v.Tags, "Environment", "test"),
+					resource.TestCheckResourceAttr(
+						"aws_default_route_table.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_default_route_table.foo", "tags.Name", "tf-default-route-table-test"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDefaultRouteTableDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -301,3 +327,28 @@ resource "aws_default_route_table" "foo" {
     }
 }
 `
+
+const testAccDefaultRouteTable_defaultTags = `
+provider "aws" {
+  default_tags {
+    tags {
+      Environment = "test"
+    }
+  }
+}
+
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "tf-default-route-table-test"
+  }
+}
+
+resource "aws_default_route_table" "foo" {
+  default_route_table_id = "${aws_vpc.foo.default_route_table_id}"
+
+  tags {
+    Name = "tf-default-route-table-test"
+  }
+}`
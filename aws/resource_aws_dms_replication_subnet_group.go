@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -60,7 +59,7 @@ func resourceAwsDmsReplicationSubnetGroupCreate(d *schema.ResourceData, meta int
 		ReplicationSubnetGroupIdentifier:  aws.String(d.Get("replication_subnet_group_id").(string)),
 		ReplicationSubnetGroupDescription: aws.String(d.Get("replication_subnet_group_description").(string)),
 		SubnetIds:                         expandStringList(d.Get("subnet_ids").(*schema.Set).List()),
-		Tags:                              dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                              dmsTagsFromMap(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{}))),
 	}
 
 	log.Println("[DEBUG] DMS create replication subnet group:", request)
@@ -95,8 +94,8 @@ func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta inter
 
 	// The AWS API for DMS subnet groups does not return the ARN which is required to
 	// retrieve tags. This ARN can be built.
-	d.Set("replication_subnet_group_arn", fmt.Sprintf("arn:aws:dms:%s:%s:subgrp:%s",
-		meta.(*AWSClient).region, meta.(*AWSClient).accountid, d.Id()))
+	d.Set("replication_subnet_group_arn", arnString(meta.(*AWSClient).partition, "dms",
+		meta.(*AWSClient).region, meta.(*AWSClient).accountid, "subgrp:"+d.Id()))
 
 	err = resourceAwsDmsReplicationSubnetGroupSetState(d, response.ReplicationSubnetGroups[0])
 	if err != nil {
@@ -109,7 +108,7 @@ func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	d.Set("tags", tagsForState(d, meta, dmsTagsToMap(tagsResp.TagList)))
 
 	return nil
 }
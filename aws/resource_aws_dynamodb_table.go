@@ -318,7 +318,7 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	_, timeToLiveOk := d.GetOk("ttl")
-	_, tagsOk := d.GetOk("tags")
+	tagsOk := len(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{}))) > 0
 
 	attemptCount := 1
 	for attemptCount <= DYNAMODB_MAX_THROTTLE_RETRIES {
@@ -638,7 +638,7 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	// Update tags
-	if err := setTagsDynamoDb(dynamodbconn, d); err != nil {
+	if err := setTagsDynamoDb(dynamodbconn, d, meta); err != nil {
 		return err
 	}
 
@@ -824,7 +824,7 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 	if len(tags) != 0 {
-		d.Set("tags", tags)
+		d.Set("tags", tagsForState(d, meta, tags))
 	}
 
 	return nil
@@ -1056,7 +1056,7 @@ func createTableTags(d *schema.ResourceData, meta interface{}) error {
 	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
 		return err
 	}
-	tags := d.Get("tags").(map[string]interface{})
+	tags := tagsWithDefaults(meta, d.Get("tags").(map[string]interface{}))
 	arn := d.Get("arn").(string)
 	dynamodbconn := meta.(*AWSClient).dynamodbconn
 	req := &dynamodb.TagResourceInput{
@@ -85,7 +85,7 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta); err != nil {
 		log.Printf("[WARN] error setting tags: %s", err)
 	}
 
@@ -116,7 +116,7 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("kms_keey_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := d.Set("tags", tagsForState(d, meta, tagsToMap(snapshot.Tags))); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
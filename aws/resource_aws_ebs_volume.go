@@ -134,10 +134,8 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
-		if err := setTags(conn, d); err != nil {
-			return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
-		}
+	if err := setTags(conn, d, meta); err != nil {
+		return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
 	}
 
 	return resourceAwsEbsVolumeRead(d, meta)
@@ -145,10 +143,8 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
-		if err := setTags(conn, d); err != nil {
-			return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
-		}
+	if err := setTags(conn, d, meta); err != nil {
+		return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
 	}
 
 	requestUpdate := false
@@ -238,7 +234,7 @@ func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading EC2 volume %s: %s", d.Id(), err)
 	}
 
-	return readVolume(d, response.Volumes[0])
+	return readVolume(d, meta, response.Volumes[0])
 }
 
 func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error {
@@ -267,7 +263,7 @@ func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error
 
 }
 
-func readVolume(d *schema.ResourceData, volume *ec2.Volume) error {
+func readVolume(d *schema.ResourceData, meta interface{}, volume *ec2.Volume) error {
 	d.SetId(*volume.VolumeId)
 
 	d.Set("availability_zone", *volume.AvailabilityZone)
@@ -297,7 +293,7 @@ func readVolume(d *schema.ResourceData, volume *ec2.Volume) error {
 		}
 	}
 
-	d.Set("tags", tagsToMap(volume.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMap(volume.Tags)))
 
 	return nil
 }
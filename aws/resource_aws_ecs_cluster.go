@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,9 @@ func resourceAwsEcsCluster() *schema.Resource {
 		Create: resourceAwsEcsClusterCreate,
 		Read:   resourceAwsEcsClusterRead,
 		Delete: resourceAwsEcsClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcsClusterImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -28,6 +32,17 @@ func resourceAwsEcsCluster() *schema.Resource {
 	}
 }
 
+func resourceAwsEcsClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The cluster is read by name, the ARN is set as ID by the read
+	name := d.Id()
+	if strings.HasPrefix(name, "arn:") {
+		name = getNameFromARN(name)
+	}
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
@@ -24,6 +24,9 @@ func resourceAwsEcsService() *schema.Resource {
 		Read:   resourceAwsEcsServiceRead,
 		Update: resourceAwsEcsServiceUpdate,
 		Delete: resourceAwsEcsServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcsServiceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -343,7 +346,9 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if service.LoadBalancers != nil {
-		d.Set("load_balancers", flattenEcsLoadBalancers(service.LoadBalancers))
+		if err := d.Set("load_balancer", flattenEcsLoadBalancers(service.LoadBalancers)); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("placement_strategy", flattenPlacementStrategy(service.PlacementStrategy)); err != nil {
@@ -356,6 +361,37 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ecsconn
+
+	parts, err := splitImportId(d.Id(), "CLUSTER-NAME/SERVICE-NAME")
+	if err != nil {
+		return nil, err
+	}
+	cluster, name := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Importing ECS service %s from cluster %s", name, cluster)
+	out, err := conn.DescribeServices(&ecs.DescribeServicesInput{
+		Services: []*string{aws.String(name)},
+		Cluster:  aws.String(cluster),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Services) < 1 || *out.Services[0].Status == "INACTIVE" {
+		return nil, fmt.Errorf("ECS service %s not found in cluster %s", name, cluster)
+	}
+	service := out.Services[0]
+
+	// The cluster and the task definition are read in the format found in
+	// state, so they are imported as ARNs like the IDs of the resources
+	d.SetId(*service.ServiceArn)
+	d.Set("cluster", service.ClusterArn)
+	d.Set("task_definition", service.TaskDefinition)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func flattenServicePlacementConstraints(pcs []*ecs.PlacementConstraint) []map[string]interface{} {
 	if len(pcs) == 0 {
 		return nil
@@ -381,11 +417,15 @@ func flattenPlacementStrategy(pss []*ecs.PlacementStrategy) []map[string]interfa
 	for _, ps := range pss {
 		c := make(map[string]interface{})
 		c["type"] = *ps.Type
-		c["field"] = *ps.Field
 
-		// for some fields the API requires lowercase for creation but will return uppercase on query
-		if *ps.Field == "MEMORY" || *ps.Field == "CPU" {
-			c["field"] = strings.ToLower(*ps.Field)
+		// the random strategy has no field
+		if ps.Field != nil {
+			c["field"] = *ps.Field
+
+			// for some fields the API requires lowercase for creation but will return uppercase on query
+			if *ps.Field == "MEMORY" || *ps.Field == "CPU" {
+				c["field"] = strings.ToLower(*ps.Field)
+			}
 		}
 
 		results = append(results, c)
@@ -2,8 +2,6 @@ package aws
 
 import (
 	"bytes"
-	"crypto/sha1"
-	"encoding/hex"
 	"fmt"
 	"log"
 	"strings"
@@ -19,6 +17,9 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 		Create: resourceAwsEcsTaskDefinitionCreate,
 		Read:   resourceAwsEcsTaskDefinitionRead,
 		Delete: resourceAwsEcsTaskDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcsTaskDefinitionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -42,10 +43,11 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				StateFunc: func(v interface{}) string {
-					hash := sha1.Sum([]byte(v.(string)))
-					return hex.EncodeToString(hash[:])
+					json, _ := normalizeJsonString(v)
+					return json
 				},
-				ValidateFunc: validateAwsEcsTaskDefinitionContainerDefinitions,
+				DiffSuppressFunc: suppressEquivalentEcsContainerDefinitionsDiffs,
+				ValidateFunc:     validateAwsEcsTaskDefinitionContainerDefinitions,
 			},
 
 			"task_role_arn": {
@@ -214,10 +216,20 @@ func resourceAwsEcsTaskDefinitionRead(d *schema.ResourceData, meta interface{})
 	d.Set("arn", taskDefinition.TaskDefinitionArn)
 	d.Set("family", taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
-	d.Set("container_definitions", taskDefinition.ContainerDefinitions)
+
+	defs, err := flattenEcsContainerDefinitions(taskDefinition.ContainerDefinitions)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("container_definitions", defs); err != nil {
+		return err
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("network_mode", taskDefinition.NetworkMode)
-	d.Set("volumes", flattenEcsVolumes(taskDefinition.Volumes))
+	if err := d.Set("volume", flattenEcsVolumes(taskDefinition.Volumes)); err != nil {
+		return err
+	}
 	if err := d.Set("placement_constraints", flattenPlacementConstraints(taskDefinition.PlacementConstraints)); err != nil {
 		log.Printf("[ERR] Error setting placement_constraints for (%s): %s", d.Id(), err)
 	}
@@ -254,6 +266,23 @@ func resourceAwsEcsTaskDefinitionDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceAwsEcsTaskDefinitionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ecsconn
+
+	// The task definition is imported by its ARN or family:revision, the
+	// latter being resolved to the ARN read by resourceAwsEcsTaskDefinitionRead
+	out, err := conn.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("arn", out.TaskDefinition.TaskDefinitionArn)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsEcsTaskDefinitionVolumeHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
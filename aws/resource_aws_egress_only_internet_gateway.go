@@ -18,6 +18,9 @@ func resourceAwsEgressOnlyInternetGateway() *schema.Resource {
 		Create: resourceAwsEgressOnlyInternetGatewayCreate,
 		Read:   resourceAwsEgressOnlyInternetGatewayRead,
 		Delete: resourceAwsEgressOnlyInternetGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -103,6 +106,9 @@ func resourceAwsEgressOnlyInternetGatewayRead(d *schema.ResourceData, meta inter
 	for _, igw := range resp.EgressOnlyInternetGateways {
 		if *igw.EgressOnlyInternetGatewayId == d.Id() {
 			found = true
+			if len(igw.Attachments) == 1 {
+				d.Set("vpc_id", igw.Attachments[0].VpcId)
+			}
 		}
 	}
 
@@ -216,6 +216,12 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(80 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
 		Schema: resourceSchema,
 	}
 }
@@ -235,7 +241,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 
 	securityNames := expandStringList(securityNameSet.List())
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapEC(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 
 	req := &elasticache.CreateCacheClusterInput{
 		CacheClusterId:          aws.String(clusterId),
@@ -316,7 +322,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 		Pending:    pending,
 		Target:     []string{"available"},
 		Refresh:    cacheClusterStateRefreshFunc(conn, d.Id(), "available", pending),
-		Timeout:    40 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
 	}
@@ -402,7 +408,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 			if len(resp.TagList) > 0 {
 				et = resp.TagList
 			}
-			d.Set("tags", tagsToMapEC(et))
+			d.Set("tags", tagsForState(d, meta, tagsToMapEC(et)))
 		}
 	}
 
@@ -415,7 +421,7 @@ func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for ElastiCache Cluster, not updating Tags for cluster %s", d.Id())
 	} else {
-		if err := setTagsEC(conn, d, arn); err != nil {
+		if err := setTagsEC(conn, d, arn, meta); err != nil {
 			return err
 		}
 	}
@@ -504,7 +510,7 @@ func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{
 			Pending:    pending,
 			Target:     []string{"available"},
 			Refresh:    cacheClusterStateRefreshFunc(conn, d.Id(), "available", pending),
-			Timeout:    80 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      30 * time.Second,
 		}
@@ -565,7 +571,9 @@ func resourceAwsElasticacheClusterDelete(d *schema.ResourceData, meta interface{
 	req := &elasticache.DeleteCacheClusterInput{
 		CacheClusterId: aws.String(d.Id()),
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	// The deletion and the wait for it to complete share the delete timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
 		_, err := conn.DeleteCacheCluster(req)
 		if err != nil {
 			awsErr, ok := err.(awserr.Error)
@@ -586,7 +594,7 @@ func resourceAwsElasticacheClusterDelete(d *schema.ResourceData, meta interface{
 		Pending:    []string{"creating", "available", "deleting", "incompatible-parameters", "incompatible-network", "restore-failed", "snapshotting"},
 		Target:     []string{},
 		Refresh:    cacheClusterStateRefreshFunc(conn, d.Id(), "", []string{}),
-		Timeout:    40 * time.Minute,
+		Timeout:    time.Until(deadline),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
 	}
@@ -681,7 +689,7 @@ func buildECARN(identifier, partition, accountid, region string) (string, error)
 	if accountid == "" {
 		return "", fmt.Errorf("Unable to construct ElastiCache ARN because of missing AWS Account ID")
 	}
-	arn := fmt.Sprintf("arn:%s:elasticache:%s:%s:cluster:%s", partition, region, accountid, identifier)
+	arn := arnString(partition, "elasticache", region, accountid, "cluster:"+identifier)
 	return arn, nil
 
 }
@@ -262,7 +262,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("name", elbName)
 	}
 
-	tags := tagsFromMapELB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapELB(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 	// Provision the elb
 	elbOpts := &elb.CreateLoadBalancerInput{
 		LoadBalancerName: aws.String(elbName),
@@ -320,7 +320,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("security_groups")
 	d.SetPartial("subnets")
 
-	d.Set("tags", tagsToMapELB(tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMapELB(tags)))
 
 	return resourceAwsElbUpdate(d, meta)
 }
@@ -438,7 +438,7 @@ func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.TagDescriptions) > 0 {
 		et = resp.TagDescriptions[0].Tags
 	}
-	d.Set("tags", tagsToMapELB(et))
+	d.Set("tags", tagsForState(d, meta, tagsToMapELB(et)))
 
 	// There's only one health check, so save that to state as we
 	// currently can
@@ -769,7 +769,7 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("subnets")
 	}
 
-	if err := setTagsELB(elbconn, d); err != nil {
+	if err := setTagsELB(elbconn, d, meta); err != nil {
 		return err
 	}
 
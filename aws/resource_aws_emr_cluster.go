@@ -23,6 +23,13 @@ func resourceAwsEMRCluster() *schema.Resource {
 		Read:   resourceAwsEMRClusterRead,
 		Update: resourceAwsEMRClusterUpdate,
 		Delete: resourceAwsEMRClusterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -357,9 +364,8 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		bootstrapActions := v.(*schema.Set).List()
 		params.BootstrapActions = expandBootstrapActions(bootstrapActions)
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		tagsIn := v.(map[string]interface{})
-		params.Tags = expandTags(tagsIn)
+	if v := tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		params.Tags = expandTags(v)
 	}
 	if v, ok := d.GetOk("configurations"); ok {
 		confUrl := v.(string)
@@ -383,7 +389,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		Pending:    []string{"STARTING", "BOOTSTRAPPING"},
 		Target:     []string{"WAITING", "RUNNING"},
 		Refresh:    resourceAwsEMRClusterStateRefreshFunc(d, meta),
-		Timeout:    75 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
@@ -451,7 +457,7 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("log_uri", cluster.LogUri)
 	d.Set("master_public_dns", cluster.MasterPublicDnsName)
 	d.Set("visible_to_all_users", cluster.VisibleToAllUsers)
-	d.Set("tags", tagsToMapEMR(cluster.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMapEMR(cluster.Tags)))
 
 	if err := d.Set("applications", flattenApplications(cluster.Applications)); err != nil {
 		log.Printf("[ERR] Error setting EMR Applications for cluster (%s): %s", d.Id(), err)
@@ -523,7 +529,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 			Pending:    []string{"STARTING", "BOOTSTRAPPING"},
 			Target:     []string{"WAITING", "RUNNING"},
 			Refresh:    resourceAwsEMRClusterStateRefreshFunc(d, meta),
-			Timeout:    40 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      5 * time.Second,
 		}
@@ -558,7 +564,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if err := setTagsEMR(conn, d); err != nil {
+	if err := setTagsEMR(conn, d, meta); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
@@ -584,7 +590,7 @@ func resourceAwsEMRClusterDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		resp, err := conn.ListInstances(&emr.ListInstancesInput{
 			ClusterId: aws.String(d.Id()),
 		})
@@ -760,82 +766,51 @@ func findGroup(grps []*emr.InstanceGroup, typ string) *emr.InstanceGroup {
 }
 
 func expandTags(m map[string]interface{}) []*emr.Tag {
-	var result []*emr.Tag
-	for k, v := range m {
-		result = append(result, &emr.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return newKeyValueTags(m).EMRTags()
 }
 
 func tagsToMapEMR(ts []*emr.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsFromEMR(ts).Map()
+}
+
+func keyValueTagsFromEMR(ts []*emr.Tag) keyValueTags {
+	tags := make(keyValueTags)
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*emr.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+func (tags keyValueTags) EMRTags() []*emr.Tag {
+	result := make([]*emr.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &emr.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
-	return expandTags(create), remove
+	return result
 }
 
-func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
+func setTagsEMR(conn *emr.EMR, d *schema.ResourceData, meta interface{}) error {
+	return updateKeyValueTags(d, meta, keyValueTagsUpdater{
+		remove: func(tags keyValueTags) error {
 			_, err := conn.RemoveTags(&emr.RemoveTagsInput{
 				ResourceId: aws.String(d.Id()),
-				TagKeys:    k,
+				TagKeys:    aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
+			return err
+		},
+		create: func(tags keyValueTags) error {
 			_, err := conn.AddTags(&emr.AddTagsInput{
 				ResourceId: aws.String(d.Id()),
-				Tags:       create,
+				Tags:       tags.EMRTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
+			return err
+		},
+	})
 }
 
 func expandBootstrapActions(bootstrapActions []interface{}) []*emr.BootstrapActionConfig {
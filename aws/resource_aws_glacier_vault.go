@@ -109,7 +109,7 @@ func resourceAwsGlacierVaultCreate(d *schema.ResourceData, meta interface{}) err
 func resourceAwsGlacierVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 	glacierconn := meta.(*AWSClient).glacierconn
 
-	if err := setGlacierVaultTags(glacierconn, d); err != nil {
+	if err := setGlacierVaultTags(glacierconn, d, meta); err != nil {
 		return err
 	}
 
@@ -154,7 +154,7 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tags)
+	d.Set("tags", tagsForState(d, meta, tags))
 
 	log.Printf("[DEBUG] Getting the access_policy for Vault %s", d.Id())
 	pol, err := glacierconn.GetVaultAccessPolicy(&glacier.GetVaultAccessPolicyInput{
@@ -271,70 +271,32 @@ func resourceAwsGlacierVaultPolicyUpdate(glacierconn *glacier.Glacier, d *schema
 	return nil
 }
 
-func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffGlacierVaultTags(mapGlacierVaultTags(o), mapGlacierVaultTags(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			tagsToRemove := &glacier.RemoveTagsFromVaultInput{
+func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData, meta interface{}) error {
+	return updateKeyValueTags(d, meta, keyValueTagsUpdater{
+		remove: func(tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromVault(&glacier.RemoveTagsFromVaultInput{
 				VaultName: aws.String(d.Id()),
-				TagKeys:   glacierStringsToPointyString(remove),
-			}
-
-			log.Printf("[DEBUG] Removing tags: from %s", d.Id())
-			_, err := conn.RemoveTagsFromVault(tagsToRemove)
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			tagsToAdd := &glacier.AddTagsToVaultInput{
+				TagKeys:   aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
+		create: func(tags keyValueTags) error {
+			_, err := conn.AddTagsToVault(&glacier.AddTagsToVaultInput{
 				VaultName: aws.String(d.Id()),
-				Tags:      glacierVaultTagsFromMap(create),
-			}
-
-			log.Printf("[DEBUG] Creating tags: for %s", d.Id())
-			_, err := conn.AddTagsToVault(tagsToAdd)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
+				Tags:      tags.Pointers(),
+			})
+			return err
+		},
+	})
 }
 
 func mapGlacierVaultTags(m map[string]interface{}) map[string]string {
-	results := make(map[string]string)
-	for k, v := range m {
-		results[k] = v.(string)
-	}
-
-	return results
+	return newKeyValueTags(m).Map()
 }
 
 func diffGlacierVaultTags(oldTags, newTags map[string]string) (map[string]string, []string) {
-
-	create := make(map[string]string)
-	for k, v := range newTags {
-		create[k] = v
-	}
-
-	// Build the list of what to remove
-	var remove []string
-	for k, v := range oldTags {
-		old, ok := create[k]
-		if !ok || old != v {
-			// Delete it!
-			remove = append(remove, k)
-		}
-	}
-
-	return create, remove
+	create, remove := diffKeyValueTags(newKeyValueTags(oldTags), newKeyValueTags(newTags))
+	return create.Map(), remove.Keys()
 }
 
 func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string) (map[string]string, error) {
@@ -354,30 +316,7 @@ func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string) (map[st
 }
 
 func glacierVaultTagsToMap(responseTags map[string]*string) map[string]string {
-	results := make(map[string]string, len(responseTags))
-	for k, v := range responseTags {
-		results[k] = *v
-	}
-
-	return results
-}
-
-func glacierVaultTagsFromMap(responseTags map[string]string) map[string]*string {
-	results := make(map[string]*string, len(responseTags))
-	for k, v := range responseTags {
-		results[k] = aws.String(v)
-	}
-
-	return results
-}
-
-func glacierStringsToPointyString(s []string) []*string {
-	results := make([]*string, len(s))
-	for i, x := range s {
-		results[i] = aws.String(x)
-	}
-
-	return results
+	return newKeyValueTags(responseTags).Map()
 }
 
 func glacierPointersToStringList(pointers []*string) []interface{} {
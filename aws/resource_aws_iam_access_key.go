@@ -19,6 +19,9 @@ func resourceAwsIamAccessKey() *schema.Resource {
 		Create: resourceAwsIamAccessKeyCreate,
 		Read:   resourceAwsIamAccessKeyRead,
 		Delete: resourceAwsIamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamAccessKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
@@ -161,6 +164,26 @@ func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceAwsIamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	// The access key ID is all that is needed to find the user owning the
+	// key, the secret cannot be read back.
+	resp, err := iamconn.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM access key %s: %s", d.Id(), err)
+	}
+	if resp.UserName == nil {
+		return nil, fmt.Errorf("IAM access key %s not found", d.Id())
+	}
+
+	d.Set("user", resp.UserName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func sesSmtpPasswordFromSecretKey(key *string) string {
 	if key == nil {
 		return ""
@@ -15,6 +15,9 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamGroupMembershipRead,
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -137,6 +140,19 @@ func resourceAwsIamGroupMembershipDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "GROUP-NAME/NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("group", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func removeUsersFromGroup(conn *iam.IAM, users []*string, group string) error {
 	for _, u := range users {
 		_, err := conn.RemoveUserFromGroup(&iam.RemoveUserFromGroupInput{
@@ -16,6 +16,9 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamGroupPolicyAttachmentCreate,
 		Read:   resourceAwsIamGroupPolicyAttachmentRead,
 		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group": &schema.Schema{
@@ -101,6 +104,19 @@ func resourceAwsIamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsIamGroupPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "GROUP-NAME/POLICY-ARN")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("group", parts[0])
+	d.Set("policy_arn", parts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", parts[0])))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToGroup(conn *iam.IAM, group string, arn string) error {
 	_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
 		GroupName: aws.String(group),
@@ -16,6 +16,9 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamRolePolicyAttachmentCreate,
 		Read:   resourceAwsIamRolePolicyAttachmentRead,
 		Delete: resourceAwsIamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamRolePolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role": &schema.Schema{
@@ -103,6 +106,19 @@ func resourceAwsIamRolePolicyAttachmentDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsIamRolePolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "ROLE-NAME/POLICY-ARN")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("role", parts[0])
+	d.Set("policy_arn", parts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", parts[0])))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToRole(conn *iam.IAM, role string, arn string) error {
 	_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
 		RoleName:  aws.String(role),
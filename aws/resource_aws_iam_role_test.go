@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"testing"
 
@@ -15,6 +16,74 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+		Name:         "aws_iam_role",
+		Dependencies: []string{"aws_instance", "aws_lambda_function"},
+		F:            testSweepIamRoles,
+	})
+}
+
+func testSweepIamRoles(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).iamconn
+
+	var roleNames []string
+	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		for _, role := range page.Roles {
+			if hasSweeperNamePrefix(*role.RoleName) {
+				roleNames = append(roleNames, *role.RoleName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing IAM roles in Sweeper: %s", err)
+	}
+
+	if len(roleNames) == 0 {
+		log.Print("[DEBUG] No aws IAM roles to sweep")
+		return nil
+	}
+
+	for _, name := range roleNames {
+		// The inline policies are managed by the aws_iam_role_policy
+		// resources, so they are removed here before deleting the role
+		var policyNames []*string
+		err := conn.ListRolePoliciesPages(&iam.ListRolePoliciesInput{
+			RoleName: aws.String(name),
+		}, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+			policyNames = append(policyNames, page.PolicyNames...)
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing policies of IAM role (%s) in Sweeper: %s", name, err)
+		}
+		for _, policyName := range policyNames {
+			_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+				RoleName:   aws.String(name),
+				PolicyName: policyName,
+			})
+			if err != nil {
+				return fmt.Errorf("Error deleting policy %s of IAM role (%s) in Sweeper: %s", *policyName, name, err)
+			}
+		}
+
+		log.Printf("[INFO] Deleting IAM role %s", name)
+		err = testSweepResource(resourceAwsIamRole(), name, map[string]string{
+			"force_detach_policies": "true",
+		}, client)
+		if err != nil {
+			return fmt.Errorf("Error deleting IAM role (%s) in Sweeper: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAWSIAMRole_basic(t *testing.T) {
 	var conf iam.GetRoleOutput
 	rName := acctest.RandString(10)
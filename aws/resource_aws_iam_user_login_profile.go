@@ -21,6 +21,9 @@ func resourceAwsIamUserLoginProfile() *schema.Resource {
 		Read:   schema.Noop,
 		Update: schema.Noop,
 		Delete: schema.RemoveFromState,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserLoginProfileImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -156,3 +159,23 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 	d.Set("encrypted_password", encrypted)
 	return nil
 }
+
+func resourceAwsIamUserLoginProfileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	resp, err := iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
+		UserName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error reading IAM User Login Profile for %q: {{err}}", d.Id()), err)
+	}
+
+	// The password cannot be read back, so the imported login profile is
+	// managed the same way as one which already existed on creation.
+	d.Set("user", resp.LoginProfile.UserName)
+	d.Set("password_reset_required", resp.LoginProfile.PasswordResetRequired)
+	d.Set("key_fingerprint", "")
+	d.Set("encrypted_password", "")
+
+	return []*schema.ResourceData{d}, nil
+}
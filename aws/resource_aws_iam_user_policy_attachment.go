@@ -16,6 +16,9 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamUserPolicyAttachmentCreate,
 		Read:   resourceAwsIamUserPolicyAttachmentRead,
 		Delete: resourceAwsIamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
@@ -100,6 +103,19 @@ func resourceAwsIamUserPolicyAttachmentDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsIamUserPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "USER-NAME/POLICY-ARN")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("user", parts[0])
+	d.Set("policy_arn", parts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", parts[0])))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToUser(conn *iam.IAM, user string, arn string) error {
 	_, err := conn.AttachUserPolicy(&iam.AttachUserPolicyInput{
 		UserName:  aws.String(user),
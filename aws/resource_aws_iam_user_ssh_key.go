@@ -17,6 +17,9 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 		Read:   resourceAwsIamUserSshKeyRead,
 		Update: resourceAwsIamUserSshKeyUpdate,
 		Delete: resourceAwsIamUserSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserSshKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ssh_public_key_id": &schema.Schema{
@@ -139,6 +142,37 @@ func resourceAwsIamUserSshKeyDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceAwsIamUserSshKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	parts, err := splitImportId(d.Id(), "USER-NAME/SSH-KEY-ID/ENCODING")
+	if err != nil {
+		return nil, err
+	}
+	username, id, encoding := parts[0], parts[1], parts[2]
+
+	if _, errs := validateIamUserSSHKeyEncoding(encoding, "encoding"); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	getResp, err := iamconn.GetSSHPublicKey(&iam.GetSSHPublicKeyInput{
+		UserName:       aws.String(username),
+		SSHPublicKeyId: aws.String(id),
+		Encoding:       aws.String(encoding),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM User SSH Key %s: %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("ssh_public_key_id", id)
+	d.Set("username", username)
+	d.Set("encoding", encoding)
+	d.Set("public_key", getResp.SSHPublicKey.SSHPublicKeyBody)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func validateIamUserSSHKeyEncoding(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	encodingTypes := map[string]bool{
@@ -41,7 +41,8 @@ func resourceAwsInstance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -68,9 +69,12 @@ func resourceAwsInstance() *schema.Resource {
 
 			"instance_type": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
+			"launch_template": launchTemplateSpecificationSchema(nil, true),
+
 			"key_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -434,6 +438,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		Ipv6AddressCount:                  instanceOpts.Ipv6AddressCount,
 		Ipv6Addresses:                     instanceOpts.Ipv6Addresses,
 		KeyName:                           instanceOpts.KeyName,
+		LaunchTemplate:                    instanceOpts.LaunchTemplate,
 		MaxCount:                          aws.Int64(int64(1)),
 		MinCount:                          aws.Int64(int64(1)),
 		NetworkInterfaces:                 instanceOpts.NetworkInterfaces,
@@ -456,8 +461,8 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	if !restricted {
 		tagsSpec := make([]*ec2.TagSpecification, 0)
 
-		if v, ok := d.GetOk("tags"); ok {
-			tags := tagsFromMap(v.(map[string]interface{}))
+		if v := tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})); len(v) > 0 {
+			tags := tagsFromMap(v)
 
 			spec := &ec2.TagSpecification{
 				ResourceType: aws.String("instance"),
@@ -699,9 +704,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMap(instance.Tags)))
 
-	if err := readVolumeTags(conn, d); err != nil {
+	if err := readVolumeTags(conn, d, meta); err != nil {
 		return err
 	}
 
@@ -759,9 +764,9 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 
-	if d.HasChange("tags") {
+	if tagsNeedUpdate(d) {
 		if !d.IsNewResource() || restricted {
-			if err := setTags(conn, d); err != nil {
+			if err := setTags(conn, d, meta); err != nil {
 				return err
 			} else {
 				d.SetPartial("tags")
@@ -770,7 +775,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	if d.HasChange("volume_tags") {
 		if !d.IsNewResource() || !restricted {
-			if err := setVolumeTags(conn, d); err != nil {
+			if err := setVolumeTags(conn, d, meta); err != nil {
 				return err
 			} else {
 				d.SetPartial("volume_tags")
@@ -1417,7 +1422,7 @@ func readBlockDeviceMappingsFromConfig(
 	return blockDevices, nil
 }
 
-func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData, meta interface{}) error {
 	volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 	if err != nil {
 		return err
@@ -1438,6 +1443,9 @@ func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	var tags []*ec2.Tag
 
 	for _, t := range tagsResp.Tags {
+		if tagKeyIgnored(meta, aws.StringValue(t.Key)) {
+			continue
+		}
 		tag := &ec2.Tag{
 			Key:   t.Key,
 			Value: t.Value,
@@ -1527,6 +1535,7 @@ type awsInstanceOpts struct {
 	Ipv6AddressCount                  *int64
 	Ipv6Addresses                     []*ec2.InstanceIpv6Address
 	KeyName                           *string
+	LaunchTemplate                    *ec2.LaunchTemplateSpecification
 	NetworkInterfaces                 []*ec2.InstanceNetworkInterfaceSpecification
 	Placement                         *ec2.Placement
 	PrivateIPAddress                  *string
@@ -1544,8 +1553,24 @@ func buildAwsInstanceOpts(
 	opts := &awsInstanceOpts{
 		DisableAPITermination: aws.Bool(d.Get("disable_api_termination").(bool)),
 		EBSOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
-		ImageID:               aws.String(d.Get("ami").(string)),
-		InstanceType:          aws.String(d.Get("instance_type").(string)),
+	}
+
+	// The AMI and instance type can be left to the launch template
+	launchTemplate, err := expandEc2LaunchTemplateSpecification(d.Get("launch_template").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	opts.LaunchTemplate = launchTemplate
+
+	if v, ok := d.GetOk("ami"); ok {
+		opts.ImageID = aws.String(v.(string))
+	} else if launchTemplate == nil {
+		return nil, fmt.Errorf("One of ami or launch_template must be set")
+	}
+	if v, ok := d.GetOk("instance_type"); ok {
+		opts.InstanceType = aws.String(v.(string))
+	} else if launchTemplate == nil {
+		return nil, fmt.Errorf("One of instance_type or launch_template must be set")
 	}
 
 	if v := d.Get("instance_initiated_shutdown_behavior").(string); v != "" {
@@ -1556,8 +1581,10 @@ func buildAwsInstanceOpts(
 		Enabled: aws.Bool(d.Get("monitoring").(bool)),
 	}
 
-	opts.IAMInstanceProfile = &ec2.IamInstanceProfileSpecification{
-		Name: aws.String(d.Get("iam_instance_profile").(string)),
+	if v := d.Get("iam_instance_profile").(string); v != "" || launchTemplate == nil {
+		opts.IAMInstanceProfile = &ec2.IamInstanceProfileSpecification{
+			Name: aws.String(v),
+		}
 	}
 
 	userData := d.Get("user_data").(string)
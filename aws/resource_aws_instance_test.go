@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"reflect"
 	"regexp"
 	"testing"
@@ -15,6 +16,68 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+		Name:         "aws_instance",
+		Dependencies: []string{"aws_autoscaling_group"},
+		F:            testSweepInstances,
+	})
+}
+
+func testSweepInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	vpcIds, err := sweeperVpcIds(conn)
+	if err != nil {
+		return err
+	}
+
+	var instanceIds []string
+	err = conn.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name: aws.String("instance-state-name"),
+				Values: []*string{
+					aws.String("pending"),
+					aws.String("running"),
+					aws.String("stopping"),
+					aws.String("stopped"),
+				},
+			},
+		},
+	}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if hasSweeperNamePrefix(sweeperEc2NameTag(instance.Tags)) || vpcIds[aws.StringValue(instance.VpcId)] {
+					instanceIds = append(instanceIds, *instance.InstanceId)
+				}
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing instances in Sweeper: %s", err)
+	}
+
+	if len(instanceIds) == 0 {
+		log.Print("[DEBUG] No aws instances to sweep")
+		return nil
+	}
+
+	for _, id := range instanceIds {
+		log.Printf("[INFO] Terminating instance %s", id)
+		if err := testSweepResource(resourceAwsInstance(), id, nil, client); err != nil {
+			return fmt.Errorf("Error terminating instance (%s) in Sweeper: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAWSInstance_basic(t *testing.T) {
 	var v ec2.Instance
 	var vol *ec2.Volume
@@ -1197,6 +1260,30 @@ func TestAccAWSInstance_associatePublic_explicitPublic(t *testing.T) {
 }
 
 // https://github.com/terraform-providers/terraform-provider-aws/issues/227
+func TestAccAWSInstance_launchTemplate(t *testing.T) {
+	var v ec2.Instance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigLaunchTemplate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &v),
+					resource.TestCheckResourceAttr("aws_instance.foo", "ami", "ami-4fccb37f"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "instance_type", "t2.small"),
+					resource.TestCheckResourceAttr(
+						"aws_instance.foo", "launch_template.0.name", fmt.Sprintf("tf-acc-test-%d", rInt)),
+					resource.TestCheckResourceAttr("aws_instance.foo", "launch_template.0.version", "$Latest"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_associatePublic_explicitPrivate(t *testing.T) {
 	var before ec2.Instance
 	resName := "aws_instance.foo"
@@ -2677,3 +2764,22 @@ resource "aws_instance" "foo" {
   }
 }`, rInt, rInt)
 }
+
+func testAccInstanceConfigLaunchTemplate(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name = "tf-acc-test-%d"
+  image_id = "ami-4fccb37f"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "foo" {
+  instance_type = "t2.small"
+
+  launch_template {
+    name = "${aws_launch_template.foo.name}"
+    version = "$Latest"
+  }
+}
+`, rInt)
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +12,59 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+		Name:         "aws_internet_gateway",
+		Dependencies: []string{"aws_instance", "aws_nat_gateway"},
+		F:            testSweepInternetGateways,
+	})
+}
+
+func testSweepInternetGateways(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	vpcIds, err := sweeperVpcIds(conn)
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{})
+	if err != nil {
+		return fmt.Errorf("Error describing Internet Gateways in Sweeper: %s", err)
+	}
+
+	swept := 0
+	for _, ig := range resp.InternetGateways {
+		var vpcId string
+		if len(ig.Attachments) > 0 {
+			vpcId = aws.StringValue(ig.Attachments[0].VpcId)
+		}
+
+		if !hasSweeperNamePrefix(sweeperEc2NameTag(ig.Tags)) && !vpcIds[vpcId] {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting Internet Gateway %s", *ig.InternetGatewayId)
+		err := testSweepResource(resourceAwsInternetGateway(), *ig.InternetGatewayId, map[string]string{
+			"vpc_id": vpcId,
+		}, client)
+		if err != nil {
+			return fmt.Errorf("Error deleting Internet Gateway (%s) in Sweeper: %s", *ig.InternetGatewayId, err)
+		}
+		swept++
+	}
+
+	if swept == 0 {
+		log.Print("[DEBUG] No aws internet gateways to sweep")
+	}
+
+	return nil
+}
+
 func TestAccAWSInternetGateway_basic(t *testing.T) {
 	var v, v2 ec2.InternetGateway
 
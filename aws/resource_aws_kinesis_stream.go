@@ -121,7 +121,7 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 	conn := meta.(*AWSClient).kinesisconn
 
 	d.Partial(true)
-	if err := setTagsKinesis(conn, d); err != nil {
+	if err := setTagsKinesis(conn, d, meta); err != nil {
 		return err
 	}
 
@@ -174,7 +174,7 @@ func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving tags for Stream: %s. %s", sn, err)
 	} else {
-		d.Set("tags", tagsToMapKinesis(tagsResp.Tags))
+		d.Set("tags", tagsForState(d, meta, tagsToMapKinesis(tagsResp.Tags)))
 	}
 
 	return nil
@@ -101,8 +101,8 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
-		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	if v := tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		req.Tags = tagsFromMapKMS(v)
 	}
 
 	var resp *kms.CreateKeyOutput
@@ -193,7 +193,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Failed to get KMS key tags (key: %s): %s", d.Get("key_id").(string), err)
 	}
-	d.Set("tags", tagsToMapKMS(tagList.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMapKMS(tagList.Tags)))
 
 	return nil
 }
@@ -234,7 +234,7 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := setTagsKMS(conn, d, d.Id()); err != nil {
+	if err := setTagsKMS(conn, d, d.Id(), meta); err != nil {
 		return err
 	}
 
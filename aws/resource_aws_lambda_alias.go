@@ -17,6 +17,9 @@ func resourceAwsLambdaAlias() *schema.Resource {
 		Read:   resourceAwsLambdaAliasRead,
 		Update: resourceAwsLambdaAliasUpdate,
 		Delete: resourceAwsLambdaAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLambdaAliasImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"description": &schema.Schema{
@@ -93,6 +96,7 @@ func resourceAwsLambdaAliasRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	d.SetId(*aliasConfiguration.AliasArn)
 	d.Set("description", aliasConfiguration.Description)
 	d.Set("function_version", aliasConfiguration.FunctionVersion)
 	d.Set("name", aliasConfiguration.Name)
@@ -144,3 +148,15 @@ func resourceAwsLambdaAliasUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func resourceAwsLambdaAliasImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "FUNCTION-NAME/ALIAS-NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("function_name", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
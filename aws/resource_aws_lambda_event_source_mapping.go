@@ -156,6 +156,13 @@ func resourceAwsLambdaEventSourceMappingRead(d *schema.ResourceData, meta interf
 	d.Set("uuid", eventSourceMappingConfiguration.UUID)
 	d.Set("function_name", eventSourceMappingConfiguration.FunctionArn)
 
+	switch aws.StringValue(eventSourceMappingConfiguration.State) {
+	case "Enabled", "Enabling":
+		d.Set("enabled", true)
+	case "Disabled", "Disabling":
+		d.Set("enabled", false)
+	}
+
 	return nil
 }
 
@@ -69,7 +69,7 @@ func TestAccAWSLambdaEventSourceMapping_importBasic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"enabled", "starting_position"},
+				ImportStateVerifyIgnore: []string{"starting_position"},
 			},
 		},
 	})
@@ -326,8 +326,8 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
-	if v, exists := d.GetOk("tags"); exists {
-		params.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v := tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		params.Tags = tagsFromMapGeneric(v)
 	}
 
 	// IAM profiles can take ~10 seconds to propagate in AWS:
@@ -396,7 +396,7 @@ func resourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("runtime", function.Runtime)
 	d.Set("timeout", function.Timeout)
 	d.Set("kms_key_arn", function.KMSKeyArn)
-	d.Set("tags", tagsToMapGeneric(getFunctionOutput.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMapGeneric(getFunctionOutput.Tags)))
 
 	config := flattenLambdaVpcConfigResponse(function.VpcConfig)
 	log.Printf("[INFO] Setting Lambda %s VPC config %#v from API", d.Id(), config)
@@ -503,7 +503,7 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 	d.Partial(true)
 
 	arn := d.Get("arn").(string)
-	if tagErr := setTagsLambda(conn, d, arn); tagErr != nil {
+	if tagErr := setTagsLambda(conn, d, arn, meta); tagErr != nil {
 		return tagErr
 	}
 	d.SetPartial("tags")
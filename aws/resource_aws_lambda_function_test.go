@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -17,6 +18,51 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+		Name: "aws_lambda_function",
+		F:    testSweepLambdaFunctions,
+	})
+}
+
+func testSweepLambdaFunctions(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).lambdaconn
+
+	var functionNames []string
+	err = conn.ListFunctionsPages(&lambda.ListFunctionsInput{}, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		for _, function := range page.Functions {
+			if hasSweeperNamePrefix(*function.FunctionName) {
+				functionNames = append(functionNames, *function.FunctionName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Lambda functions in Sweeper: %s", err)
+	}
+
+	if len(functionNames) == 0 {
+		log.Print("[DEBUG] No aws Lambda functions to sweep")
+		return nil
+	}
+
+	for _, name := range functionNames {
+		log.Printf("[INFO] Deleting Lambda function %s", name)
+		err := testSweepResource(resourceAwsLambdaFunction(), name, map[string]string{
+			"function_name": name,
+		}, client)
+		if err != nil {
+			return fmt.Errorf("Error deleting Lambda function (%s) in Sweeper: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAWSLambdaFunction_basic(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
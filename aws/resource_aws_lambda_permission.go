@@ -22,6 +22,9 @@ func resourceAwsLambdaPermission() *schema.Resource {
 		Create: resourceAwsLambdaPermissionCreate,
 		Read:   resourceAwsLambdaPermissionRead,
 		Delete: resourceAwsLambdaPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLambdaPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"action": {
@@ -229,10 +232,11 @@ func resourceAwsLambdaPermissionRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("function_name", functionName)
 	}
 
+	d.Set("statement_id", statement.Sid)
 	d.Set("action", statement.Action)
 	// Check if the pricipal is a cross-account IAM role
-	if _, ok := statement.Principal["AWS"]; ok {
-		d.Set("principal", statement.Principal["AWS"])
+	if v, ok := statement.Principal["AWS"]; ok {
+		d.Set("principal", lambdaPermissionAwsPrincipal(v, d.Get("principal").(string)))
 	} else {
 		d.Set("principal", statement.Principal["Service"])
 	}
@@ -343,6 +347,18 @@ func findLambdaPolicyStatementById(policy *LambdaPolicy, id string) (
 	}
 }
 
+// lambdaPermissionAwsPrincipal returns the principal of the statement as
+// configured: the accounts given by their ID are returned by the API as the
+// ARN of their root user.
+func lambdaPermissionAwsPrincipal(principal, configured string) string {
+	matches := regexp.MustCompile(`^arn:[\w-]+:iam::(\d{12}):root$`).FindStringSubmatch(principal)
+	if matches == nil || configured == principal {
+		return principal
+	}
+
+	return matches[1]
+}
+
 func getQualifierFromLambdaAliasOrVersionArn(arn string) (string, error) {
 	matches := regexp.MustCompile(LambdaFunctionRegexp).FindStringSubmatch(arn)
 	if len(matches) < 8 || matches[7] == "" {
@@ -376,3 +392,21 @@ type LambdaPolicyStatement struct {
 	Principal map[string]string
 	Sid       string
 }
+
+func resourceAwsLambdaPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "FUNCTION-NAME[:QUALIFIER]/STATEMENT-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	functionName := parts[0]
+	if qualifier, err := getQualifierFromLambdaAliasOrVersionArn(functionName); err == nil {
+		d.Set("qualifier", qualifier)
+		functionName = strings.TrimSuffix(functionName, ":"+qualifier)
+	}
+	d.Set("function_name", functionName)
+	d.Set("statement_id", parts[1])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -156,6 +157,72 @@ func TestLambdaPermissionGetFunctionNameFromGovCloudLambdaArn(t *testing.T) {
 	}
 }
 
+func TestLambdaPermissionAwsPrincipal(t *testing.T) {
+	cases := []struct {
+		Principal  string
+		Configured string
+		Expected   string
+	}{
+		{"arn:aws:iam::123456789012:root", "", "123456789012"},
+		{"arn:aws:iam::123456789012:root", "123456789012", "123456789012"},
+		{"arn:aws:iam::123456789012:root", "arn:aws:iam::123456789012:root", "arn:aws:iam::123456789012:root"},
+		{"arn:aws:iam::123456789012:role/iam_for_lambda", "", "arn:aws:iam::123456789012:role/iam_for_lambda"},
+	}
+
+	for _, tc := range cases {
+		if principal := lambdaPermissionAwsPrincipal(tc.Principal, tc.Configured); principal != tc.Expected {
+			t.Fatalf("%q (configured %q): expected %q, got %q", tc.Principal, tc.Configured, tc.Expected, principal)
+		}
+	}
+}
+
+func TestLambdaPermissionImport(t *testing.T) {
+	cases := []struct {
+		Id           string
+		FunctionName string
+		Qualifier    string
+		StatementId  string
+		Error        bool
+	}{
+		{"lambda_function_name/AllowExecutionFromCloudWatch", "lambda_function_name", "", "AllowExecutionFromCloudWatch", false},
+		{"lambda_function_name:testalias/AllowExecutionFromCloudWatch", "lambda_function_name", "testalias", "AllowExecutionFromCloudWatch", false},
+		{"arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name/AllowExecutionFromS3",
+			"arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name", "", "AllowExecutionFromS3", false},
+		{"arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name:12/AllowExecutionFromS3",
+			"arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name", "12", "AllowExecutionFromS3", false},
+		{"lambda_function_name", "", "", "", true},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceAwsLambdaPermission().Schema, map[string]interface{}{})
+		d.SetId(tc.Id)
+
+		_, err := resourceAwsLambdaPermissionImport(d, nil)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("%q: expected an error", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tc.Id, err)
+		}
+
+		if v := d.Get("function_name").(string); v != tc.FunctionName {
+			t.Fatalf("%q: expected function_name %q, got %q", tc.Id, tc.FunctionName, v)
+		}
+		if v := d.Get("qualifier").(string); v != tc.Qualifier {
+			t.Fatalf("%q: expected qualifier %q, got %q", tc.Id, tc.Qualifier, v)
+		}
+		if v := d.Get("statement_id").(string); v != tc.StatementId {
+			t.Fatalf("%q: expected statement_id %q, got %q", tc.Id, tc.StatementId, v)
+		}
+		if d.Id() != tc.StatementId {
+			t.Fatalf("%q: expected ID %q, got %q", tc.Id, tc.StatementId, d.Id())
+		}
+	}
+}
+
 func TestAccAWSLambdaPermission_basic(t *testing.T) {
 	var statement LambdaPolicyStatement
 	endsWithFuncName := regexp.MustCompile(":function:lambda_function_name_perm$")
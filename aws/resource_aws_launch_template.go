@@ -0,0 +1,1149 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// launchTemplateDataKeys are the arguments stored in the versions of a launch
+// template. Changing any of them creates a new version of the template.
+var launchTemplateDataKeys = []string{
+	"description",
+	"block_device_mappings",
+	"credit_specification",
+	"disable_api_termination",
+	"ebs_optimized",
+	"elastic_gpu_specifications",
+	"iam_instance_profile",
+	"image_id",
+	"instance_initiated_shutdown_behavior",
+	"instance_market_options",
+	"instance_type",
+	"kernel_id",
+	"key_name",
+	"monitoring",
+	"network_interfaces",
+	"placement",
+	"ram_disk_id",
+	"security_group_names",
+	"vpc_security_group_ids",
+	"tag_specifications",
+	"user_data",
+}
+
+func resourceAwsLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLaunchTemplateCreate,
+		Read:   resourceAwsLaunchTemplateRead,
+		Update: resourceAwsLaunchTemplateUpdate,
+		Delete: resourceAwsLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateLaunchTemplateName,
+			},
+
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLaunchTemplateName,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+
+			"default_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"block_device_mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"no_device": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"virtual_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ebs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"volume_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"standard", "unlimited"}, false),
+						},
+					},
+				},
+			},
+
+			"disable_api_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"elastic_gpu_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"iam_instance_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"instance_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ShutdownBehaviorStop,
+					ec2.ShutdownBehaviorTerminate,
+				}, false),
+			},
+
+			"instance_market_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{ec2.MarketTypeSpot}, false),
+						},
+						"spot_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_duration_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"instance_interruption_behavior": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.InstanceInterruptionBehaviorHibernate,
+											ec2.InstanceInterruptionBehaviorStop,
+											ec2.InstanceInterruptionBehaviorTerminate,
+										}, false),
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"spot_instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.SpotInstanceTypeOneTime,
+											ec2.SpotInstanceTypePersistent,
+										}, false),
+									},
+									"valid_until": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateRFC3339TimeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kernel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"monitoring": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv6_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"ipv6_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ipv4_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"ipv4_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"placement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affinity": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"spread_domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenancy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.TenancyDedicated,
+								ec2.TenancyDefault,
+								ec2.TenancyHost,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ram_disk_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"security_group_names": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"vpc_security_group_ids"},
+			},
+
+			"vpc_security_group_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"security_group_names"},
+			},
+
+			"tag_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.ResourceTypeInstance,
+								ec2.ResourceTypeVolume,
+							}, false),
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ltName string
+	if v, ok := d.GetOk("name"); ok {
+		ltName = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		ltName = resource.PrefixedUniqueId(v.(string))
+	} else {
+		ltName = resource.UniqueId()
+	}
+
+	ltData, err := buildLaunchTemplateData(d)
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.CreateLaunchTemplateInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		LaunchTemplateName: aws.String(ltName),
+		LaunchTemplateData: ltData,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating launch template: %s", input)
+	resp, err := conn.CreateLaunchTemplate(input)
+	if err != nil {
+		return fmt.Errorf("Error creating launch template %s: %s", ltName, err)
+	}
+
+	d.SetId(*resp.LaunchTemplate.LaunchTemplateId)
+	log.Printf("[INFO] Launch template ID: %s", d.Id())
+
+	return resourceAwsLaunchTemplateUpdate(d, meta)
+}
+
+func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	lt, err := getAwsLaunchTemplate(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if lt == nil {
+		log.Printf("[WARN] Launch template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("tags", tagsForState(d, meta, tagsToMap(lt.Tags)))
+
+	// The arguments are the ones of the latest version, as the updates create
+	// new versions of the template
+	resp, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(d.Id()),
+		Versions:         []*string{aws.String(strconv.FormatInt(*lt.LatestVersionNumber, 10))},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading the versions of launch template (%s): %s", d.Id(), err)
+	}
+	if len(resp.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("Error reading launch template (%s): version %d not found", d.Id(), *lt.LatestVersionNumber)
+	}
+
+	version := resp.LaunchTemplateVersions[0]
+	d.Set("description", version.VersionDescription)
+
+	return setLaunchTemplateData(d, version.LaunchTemplateData)
+}
+
+func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	d.Partial(true)
+
+	if hasLaunchTemplateDataChange(d) {
+		ltData, err := buildLaunchTemplateData(d)
+		if err != nil {
+			return err
+		}
+
+		input := &ec2.CreateLaunchTemplateVersionInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			LaunchTemplateId:   aws.String(d.Id()),
+			LaunchTemplateData: ltData,
+		}
+		if v, ok := d.GetOk("description"); ok {
+			input.VersionDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating launch template version: %s", input)
+		resp, err := conn.CreateLaunchTemplateVersion(input)
+		if err != nil {
+			return fmt.Errorf("Error creating a version of launch template (%s): %s", d.Id(), err)
+		}
+		version := aws.Int64Value(resp.LaunchTemplateVersion.VersionNumber)
+		log.Printf("[INFO] Created version %d of launch template (%s)", version, d.Id())
+
+		// Make the new version the default one, so that the resources launching
+		// instances from the $Default version pick up the update
+		_, err = conn.ModifyLaunchTemplate(&ec2.ModifyLaunchTemplateInput{
+			LaunchTemplateId: aws.String(d.Id()),
+			DefaultVersion:   aws.String(strconv.FormatInt(version, 10)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting the default version of launch template (%s) to %d: %s", d.Id(), version, err)
+		}
+
+		for _, k := range launchTemplateDataKeys {
+			d.SetPartial(k)
+		}
+	}
+
+	if err := setTags(conn, d, meta); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+
+	d.Partial(false)
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[INFO] Deleting launch template: %s", d.Id())
+	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting launch template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getAwsLaunchTemplate returns the launch template with the given ID, or nil
+// if it does not exist.
+func getAwsLaunchTemplate(conn *ec2.EC2, id string) (*ec2.LaunchTemplate, error) {
+	resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		if ec2err, ok := err.(awserr.Error); ok {
+			switch ec2err.Code() {
+			case "InvalidLaunchTemplateId.NotFound", "InvalidLaunchTemplateId.Malformed":
+				return nil, nil
+			}
+		}
+		return nil, fmt.Errorf("Error reading launch template (%s): %s", id, err)
+	}
+
+	for _, lt := range resp.LaunchTemplates {
+		if aws.StringValue(lt.LaunchTemplateId) == id {
+			return lt, nil
+		}
+	}
+	return nil, nil
+}
+
+// hasLaunchTemplateDataChange returns whether the arguments stored in the
+// versions of the template changed since its creation or last version.
+func hasLaunchTemplateDataChange(d *schema.ResourceData) bool {
+	if d.IsNewResource() {
+		return false
+	}
+	for _, k := range launchTemplateDataKeys {
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
+	opts := &ec2.RequestLaunchTemplateData{
+		DisableApiTermination: aws.Bool(d.Get("disable_api_termination").(bool)),
+		EbsOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		opts.ImageId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_initiated_shutdown_behavior"); ok {
+		opts.InstanceInitiatedShutdownBehavior = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_type"); ok {
+		opts.InstanceType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("kernel_id"); ok {
+		opts.KernelId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("key_name"); ok {
+		opts.KeyName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("ram_disk_id"); ok {
+		opts.RamDiskId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("user_data"); ok {
+		opts.UserData = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_group_names"); ok {
+		opts.SecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		opts.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	for _, v := range d.Get("block_device_mappings").([]interface{}) {
+		bdm := v.(map[string]interface{})
+		opts.BlockDeviceMappings = append(opts.BlockDeviceMappings, expandLaunchTemplateBlockDeviceMapping(bdm))
+	}
+
+	if v := d.Get("credit_specification").([]interface{}); len(v) > 0 && v[0] != nil {
+		cs := v[0].(map[string]interface{})
+		opts.CreditSpecification = &ec2.CreditSpecificationRequest{
+			CpuCredits: aws.String(cs["cpu_credits"].(string)),
+		}
+	}
+
+	for _, v := range d.Get("elastic_gpu_specifications").([]interface{}) {
+		egs := v.(map[string]interface{})
+		opts.ElasticGpuSpecifications = append(opts.ElasticGpuSpecifications, &ec2.ElasticGpuSpecification{
+			Type: aws.String(egs["type"].(string)),
+		})
+	}
+
+	if v := d.Get("iam_instance_profile").([]interface{}); len(v) > 0 && v[0] != nil {
+		iip := v[0].(map[string]interface{})
+		opts.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+		if v := iip["arn"].(string); v != "" {
+			opts.IamInstanceProfile.Arn = aws.String(v)
+		}
+		if v := iip["name"].(string); v != "" {
+			opts.IamInstanceProfile.Name = aws.String(v)
+		}
+	}
+
+	if v := d.Get("instance_market_options").([]interface{}); len(v) > 0 && v[0] != nil {
+		imo, err := expandLaunchTemplateInstanceMarketOptions(v[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		opts.InstanceMarketOptions = imo
+	}
+
+	if v := d.Get("monitoring").([]interface{}); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		opts.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{
+			Enabled: aws.Bool(m["enabled"].(bool)),
+		}
+	}
+
+	for _, v := range d.Get("network_interfaces").([]interface{}) {
+		ni := v.(map[string]interface{})
+		opts.NetworkInterfaces = append(opts.NetworkInterfaces, expandLaunchTemplateNetworkInterface(ni))
+	}
+
+	if v := d.Get("placement").([]interface{}); len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		opts.Placement = &ec2.LaunchTemplatePlacementRequest{}
+		if v := p["affinity"].(string); v != "" {
+			opts.Placement.Affinity = aws.String(v)
+		}
+		if v := p["availability_zone"].(string); v != "" {
+			opts.Placement.AvailabilityZone = aws.String(v)
+		}
+		if v := p["group_name"].(string); v != "" {
+			opts.Placement.GroupName = aws.String(v)
+		}
+		if v := p["host_id"].(string); v != "" {
+			opts.Placement.HostId = aws.String(v)
+		}
+		if v := p["spread_domain"].(string); v != "" {
+			opts.Placement.SpreadDomain = aws.String(v)
+		}
+		if v := p["tenancy"].(string); v != "" {
+			opts.Placement.Tenancy = aws.String(v)
+		}
+	}
+
+	for _, v := range d.Get("tag_specifications").([]interface{}) {
+		ts := v.(map[string]interface{})
+		opts.TagSpecifications = append(opts.TagSpecifications, &ec2.LaunchTemplateTagSpecificationRequest{
+			ResourceType: aws.String(ts["resource_type"].(string)),
+			Tags:         tagsFromMap(ts["tags"].(map[string]interface{})),
+		})
+	}
+
+	return opts, nil
+}
+
+func expandLaunchTemplateBlockDeviceMapping(m map[string]interface{}) *ec2.LaunchTemplateBlockDeviceMappingRequest {
+	bdm := &ec2.LaunchTemplateBlockDeviceMappingRequest{}
+
+	if v := m["device_name"].(string); v != "" {
+		bdm.DeviceName = aws.String(v)
+	}
+	if v := m["no_device"].(string); v != "" {
+		bdm.NoDevice = aws.String(v)
+	}
+	if v := m["virtual_name"].(string); v != "" {
+		bdm.VirtualName = aws.String(v)
+	}
+
+	if v := m["ebs"].([]interface{}); len(v) > 0 && v[0] != nil {
+		ebs := v[0].(map[string]interface{})
+		bdm.Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+			DeleteOnTermination: aws.Bool(ebs["delete_on_termination"].(bool)),
+			Encrypted:           aws.Bool(ebs["encrypted"].(bool)),
+		}
+		if v := ebs["iops"].(int); v > 0 {
+			bdm.Ebs.Iops = aws.Int64(int64(v))
+		}
+		if v := ebs["kms_key_id"].(string); v != "" {
+			bdm.Ebs.KmsKeyId = aws.String(v)
+		}
+		if v := ebs["snapshot_id"].(string); v != "" {
+			bdm.Ebs.SnapshotId = aws.String(v)
+		}
+		if v := ebs["volume_size"].(int); v > 0 {
+			bdm.Ebs.VolumeSize = aws.Int64(int64(v))
+		}
+		if v := ebs["volume_type"].(string); v != "" {
+			bdm.Ebs.VolumeType = aws.String(v)
+		}
+	}
+
+	return bdm
+}
+
+func expandLaunchTemplateInstanceMarketOptions(m map[string]interface{}) (*ec2.LaunchTemplateInstanceMarketOptionsRequest, error) {
+	imo := &ec2.LaunchTemplateInstanceMarketOptionsRequest{}
+
+	if v := m["market_type"].(string); v != "" {
+		imo.MarketType = aws.String(v)
+	}
+
+	if v := m["spot_options"].([]interface{}); len(v) > 0 && v[0] != nil {
+		so := v[0].(map[string]interface{})
+		imo.SpotOptions = &ec2.LaunchTemplateSpotMarketOptionsRequest{}
+		if v := so["block_duration_minutes"].(int); v > 0 {
+			imo.SpotOptions.BlockDurationMinutes = aws.Int64(int64(v))
+		}
+		if v := so["instance_interruption_behavior"].(string); v != "" {
+			imo.SpotOptions.InstanceInterruptionBehavior = aws.String(v)
+		}
+		if v := so["max_price"].(string); v != "" {
+			imo.SpotOptions.MaxPrice = aws.String(v)
+		}
+		if v := so["spot_instance_type"].(string); v != "" {
+			imo.SpotOptions.SpotInstanceType = aws.String(v)
+		}
+		if v := so["valid_until"].(string); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing spot_options valid_until %q: %s", v, err)
+			}
+			imo.SpotOptions.ValidUntil = aws.Time(t)
+		}
+	}
+
+	return imo, nil
+}
+
+func expandLaunchTemplateNetworkInterface(m map[string]interface{}) *ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
+	ni := &ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+		DeviceIndex: aws.Int64(int64(m["device_index"].(int))),
+	}
+
+	if v, ok := m["network_interface_id"].(string); ok && v != "" {
+		// The other settings are the ones of the existing network interface
+		ni.NetworkInterfaceId = aws.String(v)
+		return ni
+	}
+
+	ni.AssociatePublicIpAddress = aws.Bool(m["associate_public_ip_address"].(bool))
+	ni.DeleteOnTermination = aws.Bool(m["delete_on_termination"].(bool))
+
+	if v := m["description"].(string); v != "" {
+		ni.Description = aws.String(v)
+	}
+	if v := m["subnet_id"].(string); v != "" {
+		ni.SubnetId = aws.String(v)
+	}
+	if v := m["private_ip_address"].(string); v != "" {
+		ni.PrivateIpAddress = aws.String(v)
+	}
+	if v := m["security_groups"].(*schema.Set); v.Len() > 0 {
+		ni.Groups = expandStringSet(v)
+	}
+
+	if v := m["ipv6_address_count"].(int); v > 0 {
+		ni.Ipv6AddressCount = aws.Int64(int64(v))
+	}
+	for _, address := range m["ipv6_addresses"].(*schema.Set).List() {
+		ni.Ipv6Addresses = append(ni.Ipv6Addresses, &ec2.InstanceIpv6AddressRequest{
+			Ipv6Address: aws.String(address.(string)),
+		})
+	}
+
+	if v := m["ipv4_address_count"].(int); v > 0 {
+		ni.SecondaryPrivateIpAddressCount = aws.Int64(int64(v))
+	}
+	for _, address := range m["ipv4_addresses"].(*schema.Set).List() {
+		ni.PrivateIpAddresses = append(ni.PrivateIpAddresses, &ec2.PrivateIpAddressSpecification{
+			Primary:          aws.Bool(false),
+			PrivateIpAddress: aws.String(address.(string)),
+		})
+	}
+
+	return ni
+}
+
+func setLaunchTemplateData(d *schema.ResourceData, ltData *ec2.ResponseLaunchTemplateData) error {
+	d.Set("disable_api_termination", aws.BoolValue(ltData.DisableApiTermination))
+	d.Set("ebs_optimized", aws.BoolValue(ltData.EbsOptimized))
+	d.Set("image_id", ltData.ImageId)
+	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
+	d.Set("instance_type", ltData.InstanceType)
+	d.Set("kernel_id", ltData.KernelId)
+	d.Set("key_name", ltData.KeyName)
+	d.Set("ram_disk_id", ltData.RamDiskId)
+	d.Set("user_data", ltData.UserData)
+
+	if err := d.Set("security_group_names", flattenStringList(ltData.SecurityGroups)); err != nil {
+		return fmt.Errorf("Error setting security_group_names: %s", err)
+	}
+	if err := d.Set("vpc_security_group_ids", flattenStringList(ltData.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("Error setting vpc_security_group_ids: %s", err)
+	}
+
+	blockDeviceMappings := make([]interface{}, 0, len(ltData.BlockDeviceMappings))
+	for _, bdm := range ltData.BlockDeviceMappings {
+		m := map[string]interface{}{
+			"device_name":  aws.StringValue(bdm.DeviceName),
+			"no_device":    aws.StringValue(bdm.NoDevice),
+			"virtual_name": aws.StringValue(bdm.VirtualName),
+		}
+		if bdm.Ebs != nil {
+			m["ebs"] = []interface{}{
+				map[string]interface{}{
+					"delete_on_termination": aws.BoolValue(bdm.Ebs.DeleteOnTermination),
+					"encrypted":             aws.BoolValue(bdm.Ebs.Encrypted),
+					"iops":                  int(aws.Int64Value(bdm.Ebs.Iops)),
+					"kms_key_id":            aws.StringValue(bdm.Ebs.KmsKeyId),
+					"snapshot_id":           aws.StringValue(bdm.Ebs.SnapshotId),
+					"volume_size":           int(aws.Int64Value(bdm.Ebs.VolumeSize)),
+					"volume_type":           aws.StringValue(bdm.Ebs.VolumeType),
+				},
+			}
+		}
+		blockDeviceMappings = append(blockDeviceMappings, m)
+	}
+	if err := d.Set("block_device_mappings", blockDeviceMappings); err != nil {
+		return fmt.Errorf("Error setting block_device_mappings: %s", err)
+	}
+
+	var creditSpecification []interface{}
+	if cs := ltData.CreditSpecification; cs != nil {
+		creditSpecification = []interface{}{
+			map[string]interface{}{
+				"cpu_credits": aws.StringValue(cs.CpuCredits),
+			},
+		}
+	}
+	if err := d.Set("credit_specification", creditSpecification); err != nil {
+		return fmt.Errorf("Error setting credit_specification: %s", err)
+	}
+
+	elasticGpuSpecifications := make([]interface{}, 0, len(ltData.ElasticGpuSpecifications))
+	for _, egs := range ltData.ElasticGpuSpecifications {
+		elasticGpuSpecifications = append(elasticGpuSpecifications, map[string]interface{}{
+			"type": aws.StringValue(egs.Type),
+		})
+	}
+	if err := d.Set("elastic_gpu_specifications", elasticGpuSpecifications); err != nil {
+		return fmt.Errorf("Error setting elastic_gpu_specifications: %s", err)
+	}
+
+	var iamInstanceProfile []interface{}
+	if iip := ltData.IamInstanceProfile; iip != nil {
+		iamInstanceProfile = []interface{}{
+			map[string]interface{}{
+				"arn":  aws.StringValue(iip.Arn),
+				"name": aws.StringValue(iip.Name),
+			},
+		}
+	}
+	if err := d.Set("iam_instance_profile", iamInstanceProfile); err != nil {
+		return fmt.Errorf("Error setting iam_instance_profile: %s", err)
+	}
+
+	var instanceMarketOptions []interface{}
+	if imo := ltData.InstanceMarketOptions; imo != nil {
+		m := map[string]interface{}{
+			"market_type": aws.StringValue(imo.MarketType),
+		}
+		if so := imo.SpotOptions; so != nil {
+			spotOptions := map[string]interface{}{
+				"block_duration_minutes":         int(aws.Int64Value(so.BlockDurationMinutes)),
+				"instance_interruption_behavior": aws.StringValue(so.InstanceInterruptionBehavior),
+				"max_price":                      aws.StringValue(so.MaxPrice),
+				"spot_instance_type":             aws.StringValue(so.SpotInstanceType),
+			}
+			if so.ValidUntil != nil {
+				spotOptions["valid_until"] = aws.TimeValue(so.ValidUntil).Format(time.RFC3339)
+			}
+			m["spot_options"] = []interface{}{spotOptions}
+		}
+		instanceMarketOptions = []interface{}{m}
+	}
+	if err := d.Set("instance_market_options", instanceMarketOptions); err != nil {
+		return fmt.Errorf("Error setting instance_market_options: %s", err)
+	}
+
+	var monitoring []interface{}
+	if m := ltData.Monitoring; m != nil {
+		monitoring = []interface{}{
+			map[string]interface{}{
+				"enabled": aws.BoolValue(m.Enabled),
+			},
+		}
+	}
+	if err := d.Set("monitoring", monitoring); err != nil {
+		return fmt.Errorf("Error setting monitoring: %s", err)
+	}
+
+	networkInterfaces := make([]interface{}, 0, len(ltData.NetworkInterfaces))
+	for _, ni := range ltData.NetworkInterfaces {
+		var ipv6Addresses []interface{}
+		for _, address := range ni.Ipv6Addresses {
+			ipv6Addresses = append(ipv6Addresses, aws.StringValue(address.Ipv6Address))
+		}
+		var ipv4Addresses []interface{}
+		for _, address := range ni.PrivateIpAddresses {
+			if !aws.BoolValue(address.Primary) {
+				ipv4Addresses = append(ipv4Addresses, aws.StringValue(address.PrivateIpAddress))
+			}
+		}
+		networkInterfaces = append(networkInterfaces, map[string]interface{}{
+			"associate_public_ip_address": aws.BoolValue(ni.AssociatePublicIpAddress),
+			"delete_on_termination":       aws.BoolValue(ni.DeleteOnTermination),
+			"description":                 aws.StringValue(ni.Description),
+			"device_index":                int(aws.Int64Value(ni.DeviceIndex)),
+			"security_groups":             schema.NewSet(schema.HashString, flattenStringList(ni.Groups)),
+			"ipv6_address_count":          int(aws.Int64Value(ni.Ipv6AddressCount)),
+			"ipv6_addresses":              schema.NewSet(schema.HashString, ipv6Addresses),
+			"network_interface_id":        aws.StringValue(ni.NetworkInterfaceId),
+			"private_ip_address":          aws.StringValue(ni.PrivateIpAddress),
+			"ipv4_address_count":          int(aws.Int64Value(ni.SecondaryPrivateIpAddressCount)),
+			"ipv4_addresses":              schema.NewSet(schema.HashString, ipv4Addresses),
+			"subnet_id":                   aws.StringValue(ni.SubnetId),
+		})
+	}
+	if err := d.Set("network_interfaces", networkInterfaces); err != nil {
+		return fmt.Errorf("Error setting network_interfaces: %s", err)
+	}
+
+	var placement []interface{}
+	if p := ltData.Placement; p != nil {
+		placement = []interface{}{
+			map[string]interface{}{
+				"affinity":          aws.StringValue(p.Affinity),
+				"availability_zone": aws.StringValue(p.AvailabilityZone),
+				"group_name":        aws.StringValue(p.GroupName),
+				"host_id":           aws.StringValue(p.HostId),
+				"spread_domain":     aws.StringValue(p.SpreadDomain),
+				"tenancy":           aws.StringValue(p.Tenancy),
+			},
+		}
+	}
+	if err := d.Set("placement", placement); err != nil {
+		return fmt.Errorf("Error setting placement: %s", err)
+	}
+
+	tagSpecifications := make([]interface{}, 0, len(ltData.TagSpecifications))
+	for _, ts := range ltData.TagSpecifications {
+		tagSpecifications = append(tagSpecifications, map[string]interface{}{
+			"resource_type": aws.StringValue(ts.ResourceType),
+			"tags":          tagsToMap(ts.Tags),
+		})
+	}
+	if err := d.Set("tag_specifications", tagSpecifications); err != nil {
+		return fmt.Errorf("Error setting tag_specifications: %s", err)
+	}
+
+	return nil
+}
+
+// launchTemplateSpecificationSchema returns the schema of the launch_template
+// argument of the resources launching instances from a launch template. The
+// template is referenced either by id or by name, and only the one that is
+// configured is kept in state, so that switching templates is not hidden by
+// the other one.
+func launchTemplateSpecificationSchema(conflictsWith []string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      forceNew,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      forceNew,
+					ConflictsWith: []string{"launch_template.0.name"},
+				},
+				"name": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      forceNew,
+					ConflictsWith: []string{"launch_template.0.id"},
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "$Default",
+					ForceNew:     forceNew,
+					ValidateFunc: validateLaunchTemplateVersion,
+				},
+			},
+		},
+	}
+}
+
+func expandEc2LaunchTemplateSpecification(l []interface{}) (*ec2.LaunchTemplateSpecification, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	m := l[0].(map[string]interface{})
+
+	spec := &ec2.LaunchTemplateSpecification{
+		Version: aws.String(m["version"].(string)),
+	}
+	if v := m["id"].(string); v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	} else if v := m["name"].(string); v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	} else {
+		return nil, fmt.Errorf("One of id or name must be set in launch_template")
+	}
+
+	return spec, nil
+}
+
+func expandAutoScalingLaunchTemplateSpecification(l []interface{}) (*autoscaling.LaunchTemplateSpecification, error) {
+	spec, err := expandEc2LaunchTemplateSpecification(l)
+	if spec == nil || err != nil {
+		return nil, err
+	}
+
+	return &autoscaling.LaunchTemplateSpecification{
+		LaunchTemplateId:   spec.LaunchTemplateId,
+		LaunchTemplateName: spec.LaunchTemplateName,
+		Version:            spec.Version,
+	}, nil
+}
+
+// flattenAutoScalingLaunchTemplateSpecification flattens the launch template
+// of an auto scaling group, referencing it the same way as the configured
+// launch_template does. The ID is used when nothing is configured, e.g. on
+// import.
+func flattenAutoScalingLaunchTemplateSpecification(spec *autoscaling.LaunchTemplateSpecification, configured []interface{}) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"version": aws.StringValue(spec.Version),
+	}
+
+	var byName bool
+	if len(configured) > 0 && configured[0] != nil {
+		byName = configured[0].(map[string]interface{})["name"].(string) != ""
+	}
+	if byName {
+		m["name"] = aws.StringValue(spec.LaunchTemplateName)
+	} else {
+		m["id"] = aws.StringValue(spec.LaunchTemplateId)
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+		Name:         "aws_launch_template",
+		Dependencies: []string{"aws_autoscaling_group", "aws_instance"},
+		F:            testSweepLaunchTemplates,
+	})
+}
+
+func testSweepLaunchTemplates(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	var ids []string
+	input := &ec2.DescribeLaunchTemplatesInput{}
+	for {
+		resp, err := conn.DescribeLaunchTemplates(input)
+		if err != nil {
+			return fmt.Errorf("Error describing Launch Templates in Sweeper: %s", err)
+		}
+		for _, lt := range resp.LaunchTemplates {
+			if hasSweeperNamePrefix(*lt.LaunchTemplateName) {
+				ids = append(ids, *lt.LaunchTemplateId)
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	if len(ids) == 0 {
+		log.Print("[DEBUG] No aws Launch Templates to sweep")
+		return nil
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting Launch Template %s", id)
+		if err := testSweepResource(resourceAwsLaunchTemplate(), id, nil, client); err != nil {
+			return fmt.Errorf("Error deleting Launch Template (%s) in Sweeper: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+func TestAccAWSLaunchTemplate_basic(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "name", fmt.Sprintf("tf-acc-test-%d", rInt)),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.0.device_name", "/dev/sda1"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.0.ebs.0.volume_size", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_namePrefix(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLaunchTemplateConfig_namePrefix,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestMatchResourceAttr(resName, "name", regexp.MustCompile("^tf-acc-test-")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_update(t *testing.T) {
+	var template ec2.LaunchTemplate
+	var id string
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					func(*terraform.State) error {
+						id = aws.StringValue(template.LaunchTemplateId)
+						return nil
+					},
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSLaunchTemplateConfig_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					func(*terraform.State) error {
+						if aws.StringValue(template.LaunchTemplateId) != id {
+							return fmt.Errorf("Expected the launch template %s to be updated in place, got %s",
+								id, aws.StringValue(template.LaunchTemplateId))
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resName, "description", "Updated template"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.small"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.0.ebs.0.volume_size", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_tags(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resName, "tags.foo", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSLaunchTemplateConfig_tagsUpdate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resName, "tags.bar", "baz"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Launch Template ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.LaunchTemplates) != 1 || *resp.LaunchTemplates[0].LaunchTemplateId != rs.Primary.ID {
+			return fmt.Errorf("Launch Template not found")
+		}
+
+		*t = *resp.LaunchTemplates[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_launch_template" {
+			continue
+		}
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err == nil {
+			if len(resp.LaunchTemplates) != 0 && *resp.LaunchTemplates[0].LaunchTemplateId == rs.Primary.ID {
+				return fmt.Errorf("Launch Template still exists")
+			}
+			continue
+		}
+
+		ae, ok := err.(awserr.Error)
+		if !ok {
+			return err
+		}
+		if ae.Code() != "InvalidLaunchTemplateId.NotFound" {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLaunchTemplateConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name = "tf-acc-test-%d"
+  image_id = "ami-21f78e11"
+  instance_type = "t2.micro"
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+    ebs {
+      volume_size = 20
+    }
+  }
+
+  tags {
+    foo = "bar"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSLaunchTemplateConfig_update(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name = "tf-acc-test-%d"
+  description = "Updated template"
+  image_id = "ami-21f78e11"
+  instance_type = "t2.small"
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+    ebs {
+      volume_size = 30
+    }
+  }
+
+  tags {
+    foo = "bar"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSLaunchTemplateConfig_tagsUpdate(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name = "tf-acc-test-%d"
+  image_id = "ami-21f78e11"
+  instance_type = "t2.micro"
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+    ebs {
+      volume_size = 20
+    }
+  }
+
+  tags {
+    bar = "baz"
+  }
+}
+`, rInt)
+}
+
+const testAccAWSLaunchTemplateConfig_namePrefix = `
+resource "aws_launch_template" "foo" {
+  name_prefix = "tf-acc-test-"
+  image_id = "ami-21f78e11"
+  instance_type = "t2.micro"
+}
+`
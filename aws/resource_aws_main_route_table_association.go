@@ -15,6 +15,9 @@ func resourceAwsMainRouteTableAssociation() *schema.Resource {
 		Read:   resourceAwsMainRouteTableAssociationRead,
 		Update: resourceAwsMainRouteTableAssociationUpdate,
 		Delete: resourceAwsMainRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsMainRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -167,3 +170,26 @@ func findMainRouteTable(conn *ec2.EC2, vpcId string) (*ec2.RouteTable, error) {
 
 	return routeResp.RouteTables[0], nil
 }
+
+// The main route table associations are imported using the VPC ID. As the
+// route table created along with the VPC cannot be told apart once replaced,
+// the route table associated on import is recorded as the original one.
+func resourceAwsMainRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+	vpcId := d.Id()
+
+	mainAssociation, err := findMainRouteTableAssociation(conn, vpcId)
+	if err != nil {
+		return nil, err
+	}
+	if mainAssociation == nil {
+		return nil, fmt.Errorf("Main route table association of VPC %s not found", vpcId)
+	}
+
+	d.Set("vpc_id", vpcId)
+	d.Set("route_table_id", mainAssociation.RouteTableId)
+	d.Set("original_route_table_id", mainAssociation.RouteTableId)
+	d.SetId(*mainAssociation.RouteTableAssociationId)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -23,6 +23,11 @@ func resourceAwsNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"allocation_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -85,7 +90,7 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		Pending: []string{"pending"},
 		Target:  []string{"available"},
 		Refresh: NGStateRefreshFunc(conn, d.Id()),
-		Timeout: 10 * time.Minute,
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -129,7 +134,7 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_ip", address.PublicIp)
 
 	// Tags
-	d.Set("tags", tagsToMap(ng.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMap(ng.Tags)))
 
 	return nil
 }
@@ -140,7 +145,7 @@ func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	// Turn on partial mode
 	d.Partial(true)
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta); err != nil {
 		return err
 	}
 	d.SetPartial("tags")
@@ -174,7 +179,7 @@ func resourceAwsNatGatewayDelete(d *schema.ResourceData, meta interface{}) error
 		Pending:    []string{"deleting"},
 		Target:     []string{"deleted"},
 		Refresh:    NGStateRefreshFunc(conn, d.Id()),
-		Timeout:    30 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
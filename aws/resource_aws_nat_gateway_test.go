@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"testing"
 
@@ -12,6 +13,60 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+		Name: "aws_nat_gateway",
+		F:    testSweepNatGateways,
+	})
+}
+
+func testSweepNatGateways(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	vpcIds, err := sweeperVpcIds(conn)
+	if err != nil {
+		return err
+	}
+
+	var natGatewayIds []string
+	err = conn.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String("pending"), aws.String("available")},
+			},
+		},
+	}, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, ng := range page.NatGateways {
+			if hasSweeperNamePrefix(sweeperEc2NameTag(ng.Tags)) || vpcIds[aws.StringValue(ng.VpcId)] {
+				natGatewayIds = append(natGatewayIds, *ng.NatGatewayId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing NAT Gateways in Sweeper: %s", err)
+	}
+
+	if len(natGatewayIds) == 0 {
+		log.Print("[DEBUG] No aws NAT gateways to sweep")
+		return nil
+	}
+
+	for _, id := range natGatewayIds {
+		log.Printf("[INFO] Deleting NAT Gateway %s", id)
+		if err := testSweepResource(resourceAwsNatGateway(), id, nil, client); err != nil {
+			return fmt.Errorf("Error deleting NAT Gateway (%s) in Sweeper: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAWSNatGateway_basic(t *testing.T) {
 	var natGateway ec2.NatGateway
 
@@ -158,6 +213,11 @@ resource "aws_nat_gateway" "gateway" {
   allocation_id = "${aws_eip.nat_gateway.id}"
   subnet_id = "${aws_subnet.public.id}"
 
+  timeouts {
+    create = "15m"
+    delete = "45m"
+  }
+
   depends_on = ["aws_internet_gateway.gw"]
 }
 
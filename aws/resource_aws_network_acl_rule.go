@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Create: resourceAwsNetworkAclRuleCreate,
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkAclRuleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
@@ -306,3 +310,31 @@ func validateICMPArgumentValue(v interface{}, k string) (ws []string, errors []e
 	}
 	return
 }
+
+// The network ACL rules are imported using the network ACL ID, the rule
+// number, the protocol and whether the rule is an egress rule joined by
+// colons, e.g. acl-7aaabd18:100:tcp:false.
+func resourceAwsNetworkAclRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 4 || parts[0] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NETWORK-ACL-ID:RULE-NUMBER:PROTOCOL:EGRESS", d.Id())
+	}
+
+	ruleNumber, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid rule number %q: %s", parts[1], err)
+	}
+	egress, err := strconv.ParseBool(parts[3])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid egress %q: %s", parts[3], err)
+	}
+
+	networkAclId, protocol := parts[0], parts[2]
+	d.Set("network_acl_id", networkAclId)
+	d.Set("rule_number", ruleNumber)
+	d.Set("protocol", protocol)
+	d.Set("egress", egress)
+	d.SetId(networkAclIdRuleNumberEgressHash(networkAclId, ruleNumber, egress, protocol))
+
+	return []*schema.ResourceData{d}, nil
+}
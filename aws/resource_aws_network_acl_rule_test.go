@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -33,6 +34,42 @@ func TestAccAWSNetworkAclRule_basic(t *testing.T) {
 	})
 }
 
+func TestResourceAWSNetworkAclRule_import(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAwsNetworkAclRule().Schema, map[string]interface{}{})
+	d.SetId("acl-7aaabd18:100:tcp:true")
+
+	results, err := resourceAwsNetworkAclRuleImport(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 imported resource, got %d", len(results))
+	}
+
+	if v := d.Get("network_acl_id").(string); v != "acl-7aaabd18" {
+		t.Fatalf("Expected network_acl_id to be acl-7aaabd18, got %s", v)
+	}
+	if v := d.Get("rule_number").(int); v != 100 {
+		t.Fatalf("Expected rule_number to be 100, got %d", v)
+	}
+	if v := d.Get("protocol").(string); v != "tcp" {
+		t.Fatalf("Expected protocol to be tcp, got %s", v)
+	}
+	if v := d.Get("egress").(bool); !v {
+		t.Fatal("Expected egress to be true")
+	}
+	if expected := networkAclIdRuleNumberEgressHash("acl-7aaabd18", 100, true, "tcp"); d.Id() != expected {
+		t.Fatalf("Expected ID to be %s, got %s", expected, d.Id())
+	}
+
+	for _, id := range []string{"acl-7aaabd18:100:tcp", "acl-7aaabd18:a:tcp:true", "acl-7aaabd18:100:tcp:maybe", ":100:tcp:true"} {
+		d.SetId(id)
+		if _, err := resourceAwsNetworkAclRuleImport(d, nil); err == nil {
+			t.Fatalf("%q: expected an error", id)
+		}
+	}
+}
+
 func TestAccAWSNetworkAclRule_missingParam(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -18,6 +18,9 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 		Update: resourceAwsOpsworksSetPermission,
 		Delete: resourceAwsOpsworksPermissionDelete,
 		Read:   resourceAwsOpsworksPermissionRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -157,3 +160,16 @@ func resourceAwsOpsworksSetPermission(d *schema.ResourceData, meta interface{})
 
 	return resourceAwsOpsworksPermissionRead(d, meta)
 }
+
+func resourceAwsOpsworksPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "STACK-ID/USER-ARN")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("stack_id", parts[0])
+	d.Set("user_arn", parts[1])
+	d.SetId(parts[1] + parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
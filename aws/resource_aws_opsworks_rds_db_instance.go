@@ -18,6 +18,9 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 		Update: resourceAwsOpsworksRdsDbInstanceUpdate,
 		Delete: resourceAwsOpsworksRdsDbInstanceDeregister,
 		Read:   resourceAwsOpsworksRdsDbInstanceRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksRdsDbInstanceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -200,3 +203,19 @@ func resourceAwsOpsworksRdsDbInstanceRegister(d *schema.ResourceData, meta inter
 
 	return resourceAwsOpsworksRdsDbInstanceRead(d, meta)
 }
+
+// resourceAwsOpsworksRdsDbInstanceImport imports the registration of a
+// database in a stack from STACK-ID/RDS-DB-INSTANCE-ARN. The password is
+// never returned by the API, so it is left for the configuration to set.
+func resourceAwsOpsworksRdsDbInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "STACK-ID/RDS-DB-INSTANCE-ARN")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("stack_id", parts[0])
+	d.Set("rds_db_instance_arn", parts[1])
+	d.SetId(parts[1] + parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -546,7 +546,7 @@ func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) er
 		Resource:  fmt.Sprintf("stack/%s/", d.Id()),
 	}
 
-	if tagErr := setTagsOpsworks(client, d, arn.String()); tagErr != nil {
+	if tagErr := setTagsOpsworks(client, d, arn.String(), meta); tagErr != nil {
 		return tagErr
 	}
 
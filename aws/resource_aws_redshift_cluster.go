@@ -24,6 +24,12 @@ func resourceAwsRedshiftCluster() *schema.Resource {
 			State: resourceAwsRedshiftClusterImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"database_name": {
 				Type:         schema.TypeString,
@@ -267,7 +273,7 @@ func resourceAwsRedshiftClusterImport(
 
 func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).redshiftconn
-	tags := tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRedshift(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 
 	if v, ok := d.GetOk("snapshot_identifier"); ok {
 		restoreOpts := &redshift.RestoreFromClusterSnapshotInput{
@@ -427,7 +433,7 @@ func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"creating", "backing-up", "modifying", "restoring"},
 		Target:     []string{"available"},
 		Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(d, meta),
-		Timeout:    75 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 	}
 
@@ -548,7 +554,7 @@ func resourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("cluster_public_key", rsc.ClusterPublicKey)
 	d.Set("cluster_revision_number", rsc.ClusterRevisionNumber)
-	d.Set("tags", tagsToMapRedshift(rsc.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMapRedshift(rsc.Tags)))
 
 	d.Set("bucket_name", loggingStatus.BucketName)
 	d.Set("enable_logging", loggingStatus.LoggingEnabled)
@@ -565,7 +571,7 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 	if tagErr != nil {
 		return fmt.Errorf("Error building ARN for Redshift Cluster, not updating Tags for cluster %s", d.Id())
 	} else {
-		if tagErr := setTagsRedshift(conn, d, arn); tagErr != nil {
+		if tagErr := setTagsRedshift(conn, d, arn, meta); tagErr != nil {
 			return tagErr
 		} else {
 			d.SetPartial("tags")
@@ -697,7 +703,7 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 			Pending:    []string{"creating", "deleting", "rebooting", "resizing", "renaming", "modifying"},
 			Target:     []string{"available"},
 			Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(d, meta),
-			Timeout:    40 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 		}
 
@@ -778,7 +784,9 @@ func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Redshift Cluster delete options: %s", deleteOpts)
-	err := resource.Retry(15*time.Minute, func() *resource.RetryError {
+	// The deletion and the wait for it to complete share the delete timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
 		_, err := conn.DeleteCluster(&deleteOpts)
 		awsErr, ok := err.(awserr.Error)
 		if ok && awsErr.Code() == "InvalidClusterState" {
@@ -796,7 +804,7 @@ func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"available", "creating", "deleting", "rebooting", "resizing", "renaming", "final-snapshot"},
 		Target:     []string{"destroyed"},
 		Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(d, meta),
-		Timeout:    40 * time.Minute,
+		Timeout:    time.Until(deadline),
 		MinTimeout: 5 * time.Second,
 	}
 
@@ -958,7 +966,7 @@ func buildRedshiftARN(identifier, partition, accountid, region string) (string,
 	if accountid == "" {
 		return "", fmt.Errorf("Unable to construct cluster ARN because of missing AWS Account ID")
 	}
-	arn := fmt.Sprintf("arn:%s:redshift:%s:%s:cluster:%s", partition, region, accountid, identifier)
+	arn := arnString(partition, "redshift", region, accountid, "cluster:"+identifier)
 	return arn, nil
 
 }
@@ -57,7 +57,7 @@ func resourceAwsRedshiftSubnetGroupCreate(d *schema.ResourceData, meta interface
 	for i, subnetId := range subnetIdsSet.List() {
 		subnetIds[i] = aws.String(subnetId.(string))
 	}
-	tags := tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRedshift(tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})))
 
 	createOpts := redshift.CreateClusterSubnetGroupInput{
 		ClusterSubnetGroupName: aws.String(d.Get("name").(string)),
@@ -101,7 +101,7 @@ func resourceAwsRedshiftSubnetGroupRead(d *schema.ResourceData, meta interface{}
 	d.Set("name", d.Id())
 	d.Set("description", describeResp.ClusterSubnetGroups[0].Description)
 	d.Set("subnet_ids", subnetIdsToSlice(describeResp.ClusterSubnetGroups[0].Subnets))
-	if err := d.Set("tags", tagsToMapRedshift(describeResp.ClusterSubnetGroups[0].Tags)); err != nil {
+	if err := d.Set("tags", tagsForState(d, meta, tagsToMapRedshift(describeResp.ClusterSubnetGroups[0].Tags))); err != nil {
 		return fmt.Errorf("[DEBUG] Error setting Redshift Subnet Group Tags: %#v", err)
 	}
 
@@ -115,7 +115,7 @@ func resourceAwsRedshiftSubnetGroupUpdate(d *schema.ResourceData, meta interface
 	if tagErr != nil {
 		return fmt.Errorf("Error building ARN for Redshift Subnet Group, not updating Tags for Subnet Group %s", d.Id())
 	} else {
-		if tagErr := setTagsRedshift(conn, d, arn); tagErr != nil {
+		if tagErr := setTagsRedshift(conn, d, arn, meta); tagErr != nil {
 			return tagErr
 		}
 	}
@@ -214,7 +214,7 @@ func buildRedshiftSubnetGroupARN(identifier, partition, accountid, region string
 	if accountid == "" {
 		return "", fmt.Errorf("Unable to construct Subnet Group ARN because of missing AWS Account ID")
 	}
-	arn := fmt.Sprintf("arn:%s:redshift:%s:%s:subnetgroup:%s", partition, region, accountid, identifier)
+	arn := arnString(partition, "redshift", region, accountid, "subnetgroup:"+identifier)
 	return arn, nil
 
 }
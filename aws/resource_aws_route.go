@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -27,6 +28,9 @@ func resourceAwsRoute() *schema.Resource {
 		Update: resourceAwsRouteUpdate,
 		Delete: resourceAwsRouteDelete,
 		Exists: resourceAwsRouteExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": {
@@ -504,3 +508,28 @@ func findResourceRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string
 		"you need to specify a CIDR block of IPv6 CIDR Block", rtbid)
 
 }
+
+// The routes are imported using the route table ID and the destination CIDR
+// block joined by an underscore, as the CIDR block contains a slash, e.g.
+// rtb-656c65616e6f72_10.42.0.0/16.
+func resourceAwsRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ROUTE-TABLE-ID_DESTINATION", d.Id())
+	}
+
+	routeTableId, destination := parts[0], parts[1]
+	d.Set("route_table_id", routeTableId)
+
+	route := &ec2.Route{}
+	if strings.Contains(destination, ":") {
+		d.Set("destination_ipv6_cidr_block", destination)
+		route.DestinationIpv6CidrBlock = aws.String(destination)
+	} else {
+		d.Set("destination_cidr_block", destination)
+		route.DestinationCidrBlock = aws.String(destination)
+	}
+	d.SetId(routeIDHash(d, route))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -225,7 +225,7 @@ func resourceAwsRoute53ZoneRead(d *schema.ResourceData, meta interface{}) error
 		tags = resp.ResourceTagSet.Tags
 	}
 
-	if err := d.Set("tags", tagsToMapR53(tags)); err != nil {
+	if err := d.Set("tags", tagsForState(d, meta, tagsToMapR53(tags))); err != nil {
 		return err
 	}
 
@@ -251,7 +251,7 @@ func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if err := setTagsR53(conn, d, "hostedzone"); err != nil {
+	if err := setTagsR53(conn, d, "hostedzone", meta); err != nil {
 		return err
 	} else {
 		d.SetPartial("tags")
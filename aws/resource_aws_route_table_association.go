@@ -18,6 +18,9 @@ func resourceAwsRouteTableAssociation() *schema.Resource {
 		Read:   resourceAwsRouteTableAssociationRead,
 		Update: resourceAwsRouteTableAssociationUpdate,
 		Delete: resourceAwsRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"subnet_id": &schema.Schema{
@@ -153,3 +156,32 @@ func resourceAwsRouteTableAssociationDelete(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+func resourceAwsRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	parts, err := splitImportId(d.Id(), "SUBNET-ID/ROUTE-TABLE-ID")
+	if err != nil {
+		return nil, err
+	}
+	subnetId, routeTableId := parts[0], parts[1]
+
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, routeTableId)()
+	if err != nil {
+		return nil, err
+	}
+	if rtRaw == nil {
+		return nil, fmt.Errorf("Route table %s not found", routeTableId)
+	}
+
+	for _, a := range rtRaw.(*ec2.RouteTable).Associations {
+		if aws.StringValue(a.SubnetId) == subnetId {
+			d.Set("subnet_id", subnetId)
+			d.Set("route_table_id", routeTableId)
+			d.SetId(*a.RouteTableAssociationId)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Subnet %s is not associated with route table %s", subnetId, routeTableId)
+}
@@ -468,7 +468,7 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
-	if err := setTagsS3(s3conn, d); err != nil {
+	if err := setTagsS3(s3conn, d, meta); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
 
@@ -561,7 +561,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("bucket", d.Id())
 	}
 
-	d.Set("bucket_domain_name", bucketDomainName(d.Get("bucket").(string)))
+	d.Set("bucket_domain_name", bucketDomainName(d.Get("bucket").(string), meta.(*AWSClient).dnsSuffix))
 
 	// Read the policy
 	if _, ok := d.GetOk("policy"); ok {
@@ -985,11 +985,11 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapS3(tagSet)); err != nil {
+	if err := d.Set("tags", tagsForState(d, meta, tagsToMapS3(tagSet))); err != nil {
 		return err
 	}
 
-	d.Set("arn", fmt.Sprintf("arn:%s:s3:::%s", meta.(*AWSClient).partition, d.Id()))
+	d.Set("arn", arnString(meta.(*AWSClient).partition, "s3", "", "", d.Id()))
 
 	return nil
 }
@@ -1307,8 +1307,8 @@ func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error)
 	return WebsiteEndpoint(bucket, region), nil
 }
 
-func bucketDomainName(bucket string) string {
-	return fmt.Sprintf("%s.s3.amazonaws.com", bucket)
+func bucketDomainName(bucket, dnsSuffix string) string {
+	return fmt.Sprintf("%s.s3.%s", bucket, dnsSuffix)
 }
 
 func WebsiteEndpoint(bucket string, region string) *S3Website {
@@ -1322,9 +1322,9 @@ func WebsiteDomainUrl(region string) string {
 	// New regions uses different syntax for website endpoints
 	// http://docs.aws.amazon.com/AmazonS3/latest/dev/WebsiteEndpoints.html
 	if isOldRegion(region) {
-		return fmt.Sprintf("s3-website-%s.amazonaws.com", region)
+		return fmt.Sprintf("s3-website-%s.%s", region, regionDnsSuffix(region))
 	}
-	return fmt.Sprintf("s3-website.%s.amazonaws.com", region)
+	return fmt.Sprintf("s3-website.%s.%s", region, regionDnsSuffix(region))
 }
 
 func isOldRegion(region string) bool {
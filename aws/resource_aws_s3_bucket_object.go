@@ -198,14 +198,14 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v := tagsWithDefaults(meta, d.Get("tags").(map[string]interface{})); len(v) > 0 {
 		if restricted {
 			return fmt.Errorf("This region does not allow for tags on S3 objects")
 		}
 
 		// The tag-set must be encoded as URL Query parameters.
 		values := url.Values{}
-		for k, v := range v.(map[string]interface{}) {
+		for k, v := range v {
 			values.Add(k, v.(string))
 		}
 		putInput.Tagging = aws.String(values.Encode())
@@ -296,7 +296,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return fmt.Errorf("Failed to get object tags (bucket: %s, key: %s): %s", bucket, key, err)
 		}
-		d.Set("tags", tagsToMapS3(tagResp.TagSet))
+		d.Set("tags", tagsForState(d, meta, tagsToMapS3(tagResp.TagSet)))
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"log"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -22,6 +23,65 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+		Name: "aws_s3_bucket",
+		F:    testSweepS3Buckets,
+	})
+}
+
+func testSweepS3Buckets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).s3conn
+
+	resp, err := conn.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		return fmt.Errorf("Error listing S3 buckets in Sweeper: %s", err)
+	}
+
+	swept := 0
+	for _, bucket := range resp.Buckets {
+		name := *bucket.Name
+		if !hasSweeperNamePrefix(name) {
+			continue
+		}
+
+		// The buckets of all the regions are listed, only the ones of the
+		// region being swept are deleted
+		location, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{
+			Bucket: bucket.Name,
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				continue
+			}
+			return fmt.Errorf("Error getting location of S3 bucket (%s) in Sweeper: %s", name, err)
+		}
+		if normalizeRegion(aws.StringValue(location.LocationConstraint)) != region {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting S3 bucket %s", name)
+		err = testSweepResource(resourceAwsS3Bucket(), name, map[string]string{
+			"bucket":        name,
+			"force_destroy": "true",
+		}, client)
+		if err != nil {
+			return fmt.Errorf("Error deleting S3 bucket (%s) in Sweeper: %s", name, err)
+		}
+		swept++
+	}
+
+	if swept == 0 {
+		log.Print("[DEBUG] No aws S3 buckets to sweep")
+	}
+
+	return nil
+}
+
 func TestAccAWSS3Bucket_basic(t *testing.T) {
 	rInt := acctest.RandInt()
 	arnRegexp := regexp.MustCompile(
@@ -1787,3 +1847,71 @@ resource "aws_s3_bucket" "test" {
 	bucket_prefix = "tf-test-"
 }
 `
+
+func TestResourceAWSS3Bucket_mockBasic(t *testing.T) {
+	m := newAwsMock(t, "s3_bucket_basic")
+	defer m.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    m.Providers(),
+		CheckDestroy: testAccCheckAWSS3BucketMockDestroy(m),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigMock(`Name = "tf-mock-bucket"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "id", "tf-mock-bucket"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "region", "us-west-2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketConfigMock(`Name = "tf-mock-bucket"
+    Environment = "test"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketMockDestroy(m *awsMock) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := m.Meta().s3conn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket" {
+				continue
+			}
+
+			_, err := conn.HeadBucket(&s3.HeadBucketInput{
+				Bucket: aws.String(rs.Primary.ID),
+			})
+			if err == nil {
+				return fmt.Errorf("S3 Bucket %s still exists", rs.Primary.ID)
+			}
+			if !isAWSErr(err, "NotFound", "") {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketConfigMock(tags string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-mock-bucket"
+
+  tags {
+    %s
+  }
+}
+`, tags)
+}
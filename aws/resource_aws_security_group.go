@@ -132,6 +132,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecurityGroupRuleDescription,
+						},
 					},
 				},
 				Set: resourceAwsSecurityGroupRuleHash,
@@ -195,6 +201,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecurityGroupRuleDescription,
+						},
 					},
 				},
 				Set: resourceAwsSecurityGroupRuleHash,
@@ -263,7 +275,7 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 			d.Id(), err)
 	}
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta); err != nil {
 		return err
 	}
 
@@ -366,7 +378,7 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[WARN] Error setting Egress rule set for (%s): %s", d.Id(), err)
 	}
 
-	d.Set("tags", tagsToMap(sg.Tags))
+	d.Set("tags", tagsForState(d, meta, tagsToMap(sg.Tags)))
 	return nil
 }
 
@@ -397,7 +409,7 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if !d.IsNewResource() {
-		if err := setTags(conn, d); err != nil {
+		if err := setTags(conn, d, meta); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
@@ -500,95 +512,84 @@ func resourceAwsSecurityGroupRuleHash(v interface{}) int {
 			buf.WriteString(fmt.Sprintf("%s-", v))
 		}
 	}
+	// The description is only part of the hash when it is set, so that the
+	// hashes of rules without a description stay the same as before.
+	if m["description"] != nil && m["description"].(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", m["description"].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
 
+// resourceAwsSecurityGroupRuleHashWithoutDescription hashes a rule the same
+// way as resourceAwsSecurityGroupRuleHash, ignoring its description.
+func resourceAwsSecurityGroupRuleHashWithoutDescription(v interface{}) int {
+	m := make(map[string]interface{})
+	for k, v := range v.(map[string]interface{}) {
+		m[k] = v
+	}
+	delete(m, "description")
+	return resourceAwsSecurityGroupRuleHash(m)
+}
+
 func resourceAwsSecurityGroupIPPermGather(groupId string, permissions []*ec2.IpPermission, ownerId *string) []map[string]interface{} {
 	ruleMap := make(map[string]map[string]interface{})
 	for _, perm := range permissions {
-		var fromPort, toPort int64
-		if v := perm.FromPort; v != nil {
-			fromPort = *v
-		}
-		if v := perm.ToPort; v != nil {
-			toPort = *v
-		}
-
-		k := fmt.Sprintf("%s-%d-%d", *perm.IpProtocol, fromPort, toPort)
-		m, ok := ruleMap[k]
-		if !ok {
-			m = make(map[string]interface{})
-			ruleMap[k] = m
-		}
-
-		m["from_port"] = fromPort
-		m["to_port"] = toPort
-		m["protocol"] = *perm.IpProtocol
+		for _, ip := range perm.IpRanges {
+			m := initSecurityGroupRule(ruleMap, perm, aws.StringValue(ip.Description))
 
-		if len(perm.IpRanges) > 0 {
 			raw, ok := m["cidr_blocks"]
 			if !ok {
 				raw = make([]string, 0, len(perm.IpRanges))
 			}
 			list := raw.([]string)
 
-			for _, ip := range perm.IpRanges {
-				list = append(list, *ip.CidrIp)
-			}
-
-			m["cidr_blocks"] = list
+			m["cidr_blocks"] = append(list, *ip.CidrIp)
 		}
 
-		if len(perm.Ipv6Ranges) > 0 {
+		for _, ip := range perm.Ipv6Ranges {
+			m := initSecurityGroupRule(ruleMap, perm, aws.StringValue(ip.Description))
+
 			raw, ok := m["ipv6_cidr_blocks"]
 			if !ok {
 				raw = make([]string, 0, len(perm.Ipv6Ranges))
 			}
 			list := raw.([]string)
 
-			for _, ip := range perm.Ipv6Ranges {
-				list = append(list, *ip.CidrIpv6)
-			}
-
-			m["ipv6_cidr_blocks"] = list
+			m["ipv6_cidr_blocks"] = append(list, *ip.CidrIpv6)
 		}
 
-		if len(perm.PrefixListIds) > 0 {
+		for _, pl := range perm.PrefixListIds {
+			m := initSecurityGroupRule(ruleMap, perm, aws.StringValue(pl.Description))
+
 			raw, ok := m["prefix_list_ids"]
 			if !ok {
 				raw = make([]string, 0, len(perm.PrefixListIds))
 			}
 			list := raw.([]string)
 
-			for _, pl := range perm.PrefixListIds {
-				list = append(list, *pl.PrefixListId)
-			}
-
-			m["prefix_list_ids"] = list
+			m["prefix_list_ids"] = append(list, *pl.PrefixListId)
 		}
 
-		groups := flattenSecurityGroups(perm.UserIdGroupPairs, ownerId)
-		for i, g := range groups {
+		for _, pair := range perm.UserIdGroupPairs {
+			m := initSecurityGroupRule(ruleMap, perm, aws.StringValue(pair.Description))
+
+			g := flattenSecurityGroups([]*ec2.UserIdGroupPair{pair}, ownerId)[0]
 			if *g.GroupId == groupId {
-				groups[i], groups = groups[len(groups)-1], groups[:len(groups)-1]
 				m["self"] = true
+				continue
 			}
-		}
 
-		if len(groups) > 0 {
 			raw, ok := m["security_groups"]
 			if !ok {
 				raw = schema.NewSet(schema.HashString, nil)
 			}
 			list := raw.(*schema.Set)
 
-			for _, g := range groups {
-				if g.GroupName != nil {
-					list.Add(*g.GroupName)
-				} else {
-					list.Add(*g.GroupId)
-				}
+			if g.GroupName != nil {
+				list.Add(*g.GroupName)
+			} else {
+				list.Add(*g.GroupId)
 			}
 
 			m["security_groups"] = list
@@ -602,6 +603,37 @@ func resourceAwsSecurityGroupIPPermGather(groupId string, permissions []*ec2.IpP
 	return rules
 }
 
+// initSecurityGroupRule returns the rule of ruleMap with the protocol, ports
+// and description of the given permission, creating it if needed. AWS keeps a
+// description per source of a permission, so sources with different
+// descriptions are gathered into different rules.
+func initSecurityGroupRule(ruleMap map[string]map[string]interface{}, perm *ec2.IpPermission, desc string) map[string]interface{} {
+	var fromPort, toPort int64
+	if v := perm.FromPort; v != nil {
+		fromPort = *v
+	}
+	if v := perm.ToPort; v != nil {
+		toPort = *v
+	}
+
+	k := fmt.Sprintf("%s-%d-%d-%s", *perm.IpProtocol, fromPort, toPort, desc)
+	m, ok := ruleMap[k]
+	if !ok {
+		m = make(map[string]interface{})
+		ruleMap[k] = m
+	}
+
+	m["from_port"] = fromPort
+	m["to_port"] = toPort
+	m["protocol"] = *perm.IpProtocol
+
+	if desc != "" {
+		m["description"] = desc
+	}
+
+	return m
+}
+
 func resourceAwsSecurityGroupUpdateRules(
 	d *schema.ResourceData, ruleset string,
 	meta interface{}, group *ec2.SecurityGroup) error {
@@ -618,11 +650,17 @@ func resourceAwsSecurityGroupUpdateRules(
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
-		remove, err := expandIPPerms(group, os.Difference(ns).List())
+		removed, added, updated := resourceAwsSecurityGroupSplitRuleChanges(os, ns)
+
+		remove, err := expandIPPerms(group, removed)
+		if err != nil {
+			return err
+		}
+		add, err := expandIPPerms(group, added)
 		if err != nil {
 			return err
 		}
-		add, err := expandIPPerms(group, ns.Difference(os).List())
+		update, err := expandIPPerms(group, updated)
 		if err != nil {
 			return err
 		}
@@ -699,10 +737,70 @@ func resourceAwsSecurityGroupUpdateRules(
 				}
 			}
 		}
+
+		if len(update) > 0 {
+			conn := meta.(*AWSClient).ec2conn
+
+			log.Printf("[DEBUG] Updating security group %#v %s rule descriptions: %#v",
+				group, ruleset, update)
+
+			var err error
+			if ruleset == "egress" {
+				req := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+					GroupId:       group.GroupId,
+					IpPermissions: update,
+				}
+				_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(req)
+			} else {
+				req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+					GroupId:       group.GroupId,
+					IpPermissions: update,
+				}
+				if group.VpcId == nil || *group.VpcId == "" {
+					req.GroupId = nil
+					req.GroupName = group.GroupName
+				}
+				_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+			}
+
+			if err != nil {
+				return fmt.Errorf(
+					"Error updating security group %s rule descriptions: %s",
+					ruleset, err)
+			}
+		}
 	}
 	return nil
 }
 
+// resourceAwsSecurityGroupSplitRuleChanges compares the old and new rules of a
+// rule set. It returns the rules to revoke, the rules to authorize, and the
+// new rules that only differ from an old rule by their description, which can
+// be updated in place without revoking and authorizing them again.
+func resourceAwsSecurityGroupSplitRuleChanges(os, ns *schema.Set) (remove, add, update []interface{}) {
+	removed := make(map[int][]interface{})
+	for _, raw := range os.Difference(ns).List() {
+		k := resourceAwsSecurityGroupRuleHashWithoutDescription(raw)
+		removed[k] = append(removed[k], raw)
+	}
+
+	for _, raw := range ns.Difference(os).List() {
+		k := resourceAwsSecurityGroupRuleHashWithoutDescription(raw)
+		if len(removed[k]) > 0 {
+			removed[k] = removed[k][1:]
+			update = append(update, raw)
+			continue
+		}
+		add = append(add, raw)
+	}
+
+	for _, rules := range removed {
+		remove = append(remove, rules...)
+	}
+
+	return remove, add, update
+}
+
 // SGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // a security group.
 func SGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
@@ -766,6 +864,13 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 		// and replaces it with self if it's ID is found
 		localHash := idHash(rType, l["protocol"].(string), int64(l["to_port"].(int)), int64(l["from_port"].(int)), selfVal)
 
+		// resourceAwsSecurityGroupIPPermGather splits the remote rules by
+		// description, so only remote rules with the same description can match
+		var localDesc string
+		if v, ok := l["description"]; ok {
+			localDesc = v.(string)
+		}
+
 		// loop remote rules, looking for a matching hash
 		for _, r := range remote {
 			var remoteSelfVal bool
@@ -776,7 +881,13 @@ func matchRules(rType string, local []interface{}, remote []map[string]interface
 			// hash this remote rule and compare it for a match consideration with the
 			// local rule we're examining
 			rHash := idHash(rType, r["protocol"].(string), r["to_port"].(int64), r["from_port"].(int64), remoteSelfVal)
-			if rHash == localHash {
+
+			var remoteDesc string
+			if v, ok := r["description"]; ok {
+				remoteDesc = v.(string)
+			}
+
+			if rHash == localHash && remoteDesc == localDesc {
 				var numExpectedCidrs, numExpectedIpv6Cidrs, numExpectedPrefixLists, numExpectedSGs, numRemoteCidrs, numRemoteIpv6Cidrs, numRemotePrefixLists, numRemoteSGs int
 				var matchingCidrs []string
 				var matchingIpv6Cidrs []string
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,7 +23,11 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSecurityGroupRuleCreate,
 		Read:   resourceAwsSecurityGroupRuleRead,
+		Update: resourceAwsSecurityGroupRuleUpdate,
 		Delete: resourceAwsSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSecurityGroupRuleImport,
+		},
 
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
@@ -102,6 +108,12 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"cidr_blocks"},
 			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSecurityGroupRuleDescription,
+			},
 		},
 	}
 }
@@ -275,9 +287,70 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	if err := setFromIPPerm(d, sg, p); err != nil {
 		return errwrap.Wrapf("Error setting IP Permission for Security Group Rule: {{err}}", err)
 	}
+
+	d.Set("description", descriptionFromIPPerm(d, rule))
+
 	return nil
 }
 
+func resourceAwsSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sg_id := d.Get("security_group_id").(string)
+
+	awsMutexKV.Lock(sg_id)
+	defer awsMutexKV.Unlock(sg_id)
+
+	if d.HasChange("description") {
+		sg, err := findResourceSecurityGroup(conn, sg_id)
+		if err != nil {
+			return err
+		}
+
+		perm, err := expandIPPerm(d, sg)
+		if err != nil {
+			return err
+		}
+
+		ruleType := d.Get("type").(string)
+		switch ruleType {
+		case "ingress":
+			log.Printf("[DEBUG] Updating description of security group %s %s rule: %s",
+				sg_id, "Ingress", perm)
+
+			req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			}
+
+			if sg.VpcId == nil || *sg.VpcId == "" {
+				req.GroupId = nil
+				req.GroupName = sg.GroupName
+			}
+
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+
+		case "egress":
+			log.Printf("[DEBUG] Updating description of security group %s %s rule: %s",
+				sg_id, "Egress", perm)
+
+			req := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			}
+
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(req)
+		}
+
+		if err != nil {
+			return fmt.Errorf(
+				"Error updating security group %s rule description: %s",
+				sg_id, err)
+		}
+	}
+
+	return resourceAwsSecurityGroupRuleRead(d, meta)
+}
+
 func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
@@ -554,6 +627,8 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 		}
 	}
 
+	description := d.Get("description").(string)
+
 	if len(groups) > 0 {
 		perm.UserIdGroupPairs = make([]*ec2.UserIdGroupPair, len(groups))
 		// build string list of group name/ids
@@ -573,6 +648,10 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 				UserId:  aws.String(ownerId),
 			}
 
+			if description != "" {
+				perm.UserIdGroupPairs[i].Description = aws.String(description)
+			}
+
 			if sg.VpcId == nil || *sg.VpcId == "" {
 				perm.UserIdGroupPairs[i].GroupId = nil
 				perm.UserIdGroupPairs[i].GroupName = aws.String(id)
@@ -590,6 +669,10 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 				return nil, fmt.Errorf("empty element found in cidr_blocks - consider using the compact function")
 			}
 			perm.IpRanges[i] = &ec2.IpRange{CidrIp: aws.String(cidrIP)}
+
+			if description != "" {
+				perm.IpRanges[i].Description = aws.String(description)
+			}
 		}
 	}
 
@@ -602,6 +685,10 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 				return nil, fmt.Errorf("empty element found in ipv6_cidr_blocks - consider using the compact function")
 			}
 			perm.Ipv6Ranges[i] = &ec2.Ipv6Range{CidrIpv6: aws.String(cidrIP)}
+
+			if description != "" {
+				perm.Ipv6Ranges[i].Description = aws.String(description)
+			}
 		}
 	}
 
@@ -614,6 +701,10 @@ func expandIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup) (*ec2.IpPermiss
 				return nil, fmt.Errorf("empty element found in prefix_list_ids - consider using the compact function")
 			}
 			perm.PrefixListIds[i] = &ec2.PrefixListId{PrefixListId: aws.String(prefixListID)}
+
+			if description != "" {
+				perm.PrefixListIds[i].Description = aws.String(description)
+			}
 		}
 	}
 
@@ -659,6 +750,74 @@ func setFromIPPerm(d *schema.ResourceData, sg *ec2.SecurityGroup, rule *ec2.IpPe
 	return nil
 }
 
+// descriptionFromIPPerm returns the description of the first source of the
+// rule found in the given remote permission. AWS keeps a description per
+// source, and Terraform sets the same description on all the sources of a rule.
+func descriptionFromIPPerm(d *schema.ResourceData, rule *ec2.IpPermission) string {
+	// probe IpRanges
+	cidrIps := make(map[string]bool)
+	if raw, ok := d.GetOk("cidr_blocks"); ok {
+		for _, v := range raw.([]interface{}) {
+			cidrIps[v.(string)] = true
+		}
+	}
+	for _, c := range rule.IpRanges {
+		if cidrIps[*c.CidrIp] && aws.StringValue(c.Description) != "" {
+			return *c.Description
+		}
+	}
+
+	// probe Ipv6Ranges
+	cidrIpv6s := make(map[string]bool)
+	if raw, ok := d.GetOk("ipv6_cidr_blocks"); ok {
+		for _, v := range raw.([]interface{}) {
+			cidrIpv6s[v.(string)] = true
+		}
+	}
+	for _, ip := range rule.Ipv6Ranges {
+		if cidrIpv6s[*ip.CidrIpv6] && aws.StringValue(ip.Description) != "" {
+			return *ip.Description
+		}
+	}
+
+	// probe PrefixListIds
+	listIds := make(map[string]bool)
+	if raw, ok := d.GetOk("prefix_list_ids"); ok {
+		for _, v := range raw.([]interface{}) {
+			listIds[v.(string)] = true
+		}
+	}
+	for _, pl := range rule.PrefixListIds {
+		if listIds[*pl.PrefixListId] && aws.StringValue(pl.Description) != "" {
+			return *pl.Description
+		}
+	}
+
+	// probe UserIdGroupPairs, which hold the source security group or self.
+	// Groups are referenced by ID in a VPC and by name in EC2-Classic.
+	groups := make(map[string]bool)
+	if raw, ok := d.GetOk("source_security_group_id"); ok {
+		id := raw.(string)
+		if items := strings.Split(id, "/"); len(items) > 1 {
+			id = items[1]
+		}
+		groups[id] = true
+	}
+	if v, ok := d.GetOk("self"); ok && v.(bool) {
+		groups[d.Get("security_group_id").(string)] = true
+	}
+	for _, pair := range rule.UserIdGroupPairs {
+		if !groups[aws.StringValue(pair.GroupId)] && !groups[aws.StringValue(pair.GroupName)] {
+			continue
+		}
+		if aws.StringValue(pair.Description) != "" {
+			return *pair.Description
+		}
+	}
+
+	return ""
+}
+
 // Validates that either 'cidr_blocks', 'ipv6_cidr_blocks', 'self', or 'source_security_group_id' is set
 func validateAwsSecurityGroupRule(d *schema.ResourceData) error {
 	_, blocksOk := d.GetOk("cidr_blocks")
@@ -672,3 +831,108 @@ func validateAwsSecurityGroupRule(d *schema.ResourceData) error {
 	}
 	return nil
 }
+
+func resourceAwsSecurityGroupRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := resourceAwsSecurityGroupRuleImportParse(d, d.Id()); err != nil {
+		return nil, err
+	}
+
+	sg_id := d.Get("security_group_id").(string)
+	sg, err := findResourceSecurityGroup(conn, sg_id)
+	if err != nil {
+		return nil, err
+	}
+
+	perm, err := expandIPPerm(d, sg)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(ipPermissionIDHash(sg_id, d.Get("type").(string), perm))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceAwsSecurityGroupRuleImportParse sets the arguments of the rule from
+// its import ID, which is made of the security group ID, the type, the
+// protocol, the from and to ports and the sources of the rule joined by
+// underscores, e.g. sg-6e616f6d69_ingress_tcp_443_443_10.0.0.0/8. The sources
+// are CIDR blocks, prefix list IDs, a source security group ID or self.
+func resourceAwsSecurityGroupRuleImportParse(d *schema.ResourceData, id string) error {
+	invalidId := func(reason string) error {
+		return fmt.Errorf("Unexpected format of ID (%q), expected "+
+			"SECURITY-GROUP-ID_TYPE_PROTOCOL_FROM-PORT_TO-PORT_SOURCE[_SOURCE]*: %s", id, reason)
+	}
+
+	parts := strings.Split(id, "_")
+	if len(parts) < 6 {
+		return invalidId("missing parts")
+	}
+
+	sgId, ruleType, protocol := parts[0], parts[1], parts[2]
+	if sgId == "" {
+		return invalidId("missing security group ID")
+	}
+	if ruleType != "ingress" && ruleType != "egress" {
+		return invalidId(fmt.Sprintf("invalid type %q", ruleType))
+	}
+	if protocol == "" {
+		return invalidId("missing protocol")
+	}
+
+	fromPort, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return invalidId(fmt.Sprintf("invalid from port %q", parts[3]))
+	}
+	toPort, err := strconv.Atoi(parts[4])
+	if err != nil {
+		return invalidId(fmt.Sprintf("invalid to port %q", parts[4]))
+	}
+
+	var cidrBlocks, ipv6CidrBlocks, prefixListIds []string
+	var sourceSecurityGroupId string
+	self := false
+	for _, source := range parts[5:] {
+		switch {
+		case source == "":
+			return invalidId("empty source")
+		case source == "self":
+			self = true
+		case strings.HasPrefix(source, "pl-"):
+			prefixListIds = append(prefixListIds, source)
+		default:
+			if ip, _, err := net.ParseCIDR(source); err == nil {
+				if ip.To4() != nil {
+					cidrBlocks = append(cidrBlocks, source)
+				} else {
+					ipv6CidrBlocks = append(ipv6CidrBlocks, source)
+				}
+				continue
+			}
+			if sourceSecurityGroupId != "" {
+				return invalidId("only one source security group can be given")
+			}
+			sourceSecurityGroupId = source
+		}
+	}
+	if self && (sourceSecurityGroupId != "" || len(cidrBlocks) > 0) {
+		return invalidId("self cannot be combined with a source security group or CIDR blocks")
+	}
+	if sourceSecurityGroupId != "" && len(cidrBlocks) > 0 {
+		return invalidId("a source security group cannot be combined with CIDR blocks")
+	}
+
+	d.Set("security_group_id", sgId)
+	d.Set("type", ruleType)
+	d.Set("protocol", protocolForValue(protocol))
+	d.Set("from_port", fromPort)
+	d.Set("to_port", toPort)
+	d.Set("cidr_blocks", cidrBlocks)
+	d.Set("ipv6_cidr_blocks", ipv6CidrBlocks)
+	d.Set("prefix_list_ids", prefixListIds)
+	d.Set("source_security_group_id", sourceSecurityGroupId)
+	d.Set("self", self)
+
+	return nil
+}
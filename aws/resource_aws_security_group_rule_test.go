@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -108,6 +110,76 @@ func TestIpPermissionIDHash(t *testing.T) {
 	}
 }
 
+func TestResourceAwsSecurityGroupRuleImportParse(t *testing.T) {
+	cases := []struct {
+		Id       string
+		Expected map[string]interface{}
+	}{
+		{
+			Id: "sg-6e616f6d69_ingress_tcp_443_443_10.0.0.0/8_10.1.0.0/16",
+			Expected: map[string]interface{}{
+				"security_group_id": "sg-6e616f6d69",
+				"type":              "ingress",
+				"protocol":          "tcp",
+				"from_port":         443,
+				"to_port":           443,
+				"cidr_blocks":       []interface{}{"10.0.0.0/8", "10.1.0.0/16"},
+				"self":              false,
+			},
+		},
+		{
+			Id: "sg-6e616f6d69_egress_all_0_0_::/0_pl-1a2b3c4d",
+			Expected: map[string]interface{}{
+				"type":             "egress",
+				"protocol":         "-1",
+				"ipv6_cidr_blocks": []interface{}{"::/0"},
+				"prefix_list_ids":  []interface{}{"pl-1a2b3c4d"},
+			},
+		},
+		{
+			Id: "sg-6e616f6d69_ingress_icmp_-1_-1_123456789012/sg-5468656f",
+			Expected: map[string]interface{}{
+				"from_port":                -1,
+				"to_port":                  -1,
+				"source_security_group_id": "123456789012/sg-5468656f",
+			},
+		},
+		{
+			Id: "sg-6e616f6d69_ingress_6_80_8000_self",
+			Expected: map[string]interface{}{
+				"protocol":                 "tcp",
+				"self":                     true,
+				"source_security_group_id": "",
+			},
+		},
+		{Id: "sg-6e616f6d69_ingress_tcp_443_443", Expected: nil},
+		{Id: "sg-6e616f6d69_inbound_tcp_443_443_10.0.0.0/8", Expected: nil},
+		{Id: "sg-6e616f6d69_ingress_tcp_https_443_10.0.0.0/8", Expected: nil},
+		{Id: "sg-6e616f6d69_ingress_tcp_443_443_sg-5468656f_sg-7a6f65", Expected: nil},
+		{Id: "sg-6e616f6d69_ingress_tcp_443_443_self_10.0.0.0/8", Expected: nil},
+		{Id: "sg-6e616f6d69_ingress_tcp_443_443_10.0.0.0/8_", Expected: nil},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroupRule().Schema, map[string]interface{}{})
+		err := resourceAwsSecurityGroupRuleImportParse(d, tc.Id)
+		if tc.Expected == nil {
+			if err == nil {
+				t.Fatalf("%q: expected an error", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tc.Id, err)
+		}
+		for k, v := range tc.Expected {
+			if actual := d.Get(k); !reflect.DeepEqual(actual, v) {
+				t.Fatalf("%q: expected %s to be %#v, got %#v", tc.Id, k, v, actual)
+			}
+		}
+	}
+}
+
 func TestAccAWSSecurityGroupRule_Ingress_VPC(t *testing.T) {
 	var group ec2.SecurityGroup
 	rInt := acctest.RandInt()
@@ -574,6 +646,51 @@ func TestAccAWSSecurityGroupRule_PrefixListEgress(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroupRule_Description(t *testing.T) {
+	var group ec2.SecurityGroup
+	var ruleId string
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRuleDescriptionConfig(rInt, "SSH from the office"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr(
+						"aws_security_group_rule.ingress_1", "description", "SSH from the office"),
+					func(s *terraform.State) error {
+						ruleId = s.RootModule().Resources["aws_security_group_rule.ingress_1"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupRuleDescriptionConfig(rInt, "SSH from the VPN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRuleExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr(
+						"aws_security_group_rule.ingress_1", "description", "SSH from the VPN"),
+					resource.TestCheckResourceAttrPtr(
+						"aws_security_group_rule.ingress_1", "id", &ruleId),
+					func(*terraform.State) error {
+						if len(group.IpPermissions) != 1 || len(group.IpPermissions[0].IpRanges) != 1 {
+							return fmt.Errorf("Expected a single ingress CIDR block, got %#v", group.IpPermissions)
+						}
+						if desc := aws.StringValue(group.IpPermissions[0].IpRanges[0].Description); desc != "SSH from the VPN" {
+							return fmt.Errorf("Expected the rule description to be updated, got %q", desc)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSecurityGroupRuleDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -755,6 +872,25 @@ func testAccAWSSecurityGroupRuleIngressConfig(rInt int) string {
 	}`, rInt)
 }
 
+func testAccAWSSecurityGroupRuleDescriptionConfig(rInt int, description string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "web" {
+  name = "tf-acc-test-%d"
+  description = "Used in the terraform acceptance tests"
+}
+
+resource "aws_security_group_rule" "ingress_1" {
+  type = "ingress"
+  protocol = "tcp"
+  from_port = 22
+  to_port = 22
+  cidr_blocks = ["10.0.0.0/8"]
+  description = "%s"
+
+  security_group_id = "${aws_security_group.web.id}"
+}`, rInt, description)
+}
+
 const testAccAWSSecurityGroupRuleIngress_ipv6Config = `
 resource "aws_vpc" "tftest" {
   cidr_block = "10.0.0.0/16"
@@ -712,3 +712,43 @@ func TestRulesMixedMatching(t *testing.T) {
 		}
 	}
 }
+
+func TestRulesMatchingDescription(t *testing.T) {
+	local := []interface{}{
+		map[string]interface{}{
+			"from_port":   22,
+			"to_port":     22,
+			"protocol":    "tcp",
+			"cidr_blocks": []interface{}{"10.0.0.0/16"},
+			"description": "Office",
+		},
+	}
+	remote := []map[string]interface{}{
+		map[string]interface{}{
+			"from_port":   int64(22),
+			"to_port":     int64(22),
+			"protocol":    "tcp",
+			"cidr_blocks": []string{"10.0.0.0/16"},
+			"description": "VPN",
+		},
+	}
+
+	// A remote rule with another description must not match the local rule,
+	// so that the description change shows up in the plan
+	saves := matchRules("ingress", local, remote)
+	if len(saves) != 1 {
+		t.Fatalf("Expected 1 save, got %d: %#v", len(saves), saves)
+	}
+	if saves[0]["description"] != "VPN" {
+		t.Fatalf("Expected the remote rule to be saved, got %#v", saves[0])
+	}
+
+	remote[0]["description"] = "Office"
+	saves = matchRules("ingress", local, remote)
+	if len(saves) != 1 {
+		t.Fatalf("Expected 1 save, got %d: %#v", len(saves), saves)
+	}
+	if _, ok := saves[0]["cidr_blocks"].([]interface{}); !ok {
+		t.Fatalf("Expected the local rule to be saved, got %#v", saves[0])
+	}
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"reflect"
 	"regexp"
 	"strings"
@@ -11,11 +12,86 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+		Name:         "aws_security_group",
+		Dependencies: []string{"aws_instance", "aws_lambda_function"},
+		F:            testSweepSecurityGroups,
+	})
+}
+
+func testSweepSecurityGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	vpcIds, err := sweeperVpcIds(conn)
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{})
+	if err != nil {
+		return fmt.Errorf("Error describing security groups in Sweeper: %s", err)
+	}
+
+	var groups []*ec2.SecurityGroup
+	for _, sg := range resp.SecurityGroups {
+		if *sg.GroupName == "default" {
+			continue
+		}
+		if hasSweeperNamePrefix(*sg.GroupName) || hasSweeperNamePrefix(sweeperEc2NameTag(sg.Tags)) ||
+			vpcIds[aws.StringValue(sg.VpcId)] {
+			groups = append(groups, sg)
+		}
+	}
+
+	if len(groups) == 0 {
+		log.Print("[DEBUG] No aws security groups to sweep")
+		return nil
+	}
+
+	// The test security groups often reference each other, so all their rules
+	// are revoked before deleting any of them
+	for _, sg := range groups {
+		if len(sg.IpPermissions) > 0 {
+			_, err := conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: sg.IpPermissions,
+			})
+			if err != nil {
+				return fmt.Errorf("Error revoking ingress rules of security group (%s) in Sweeper: %s", *sg.GroupId, err)
+			}
+		}
+		if len(sg.IpPermissionsEgress) > 0 && sg.VpcId != nil {
+			_, err := conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: sg.IpPermissionsEgress,
+			})
+			if err != nil {
+				return fmt.Errorf("Error revoking egress rules of security group (%s) in Sweeper: %s", *sg.GroupId, err)
+			}
+		}
+	}
+
+	for _, sg := range groups {
+		log.Printf("[INFO] Deleting security group %s (%s)", *sg.GroupId, *sg.GroupName)
+		if err := testSweepResource(resourceAwsSecurityGroup(), *sg.GroupId, nil, client); err != nil {
+			return fmt.Errorf("Error deleting security group (%s) in Sweeper: %s", *sg.GroupId, err)
+		}
+	}
+
+	return nil
+}
+
 func TestProtocolStateFunc(t *testing.T) {
 	cases := []struct {
 		input    interface{}
@@ -254,6 +330,134 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+func TestResourceAwsSecurityGroupIPPermGather_description(t *testing.T) {
+	raw := []*ec2.IpPermission{
+		{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(int64(22)),
+			ToPort:     aws.Int64(int64(22)),
+			IpRanges: []*ec2.IpRange{
+				{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("Office")},
+				{CidrIp: aws.String("10.1.0.0/16"), Description: aws.String("Office")},
+				{CidrIp: aws.String("10.2.0.0/16"), Description: aws.String("VPN")},
+				{CidrIp: aws.String("10.3.0.0/16")},
+			},
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{
+				{GroupId: aws.String("sg-11111"), Description: aws.String("VPN")},
+			},
+		},
+	}
+
+	expected := map[string]map[string]interface{}{
+		"Office": {
+			"protocol":    "tcp",
+			"from_port":   int64(22),
+			"to_port":     int64(22),
+			"cidr_blocks": []string{"10.0.0.0/16", "10.1.0.0/16"},
+			"description": "Office",
+		},
+		"VPN": {
+			"protocol":    "tcp",
+			"from_port":   int64(22),
+			"to_port":     int64(22),
+			"cidr_blocks": []string{"10.2.0.0/16"},
+			"self":        true,
+			"description": "VPN",
+		},
+		"": {
+			"protocol":    "tcp",
+			"from_port":   int64(22),
+			"to_port":     int64(22),
+			"cidr_blocks": []string{"10.3.0.0/16"},
+		},
+	}
+
+	out := resourceAwsSecurityGroupIPPermGather("sg-11111", raw, aws.String("12345"))
+	if len(out) != len(expected) {
+		t.Fatalf("Expected %d rules, got %d: %#v", len(expected), len(out), out)
+	}
+
+	for _, rule := range out {
+		var desc string
+		if v, ok := rule["description"]; ok {
+			desc = v.(string)
+		}
+
+		if !reflect.DeepEqual(rule, expected[desc]) {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				rule,
+				expected[desc])
+		}
+	}
+}
+
+func TestResourceAwsSecurityGroupRuleHash_description(t *testing.T) {
+	rule := map[string]interface{}{
+		"protocol":    "tcp",
+		"from_port":   22,
+		"to_port":     22,
+		"self":        false,
+		"cidr_blocks": []interface{}{"10.0.0.0/16"},
+	}
+
+	// Rules without a description must keep the hash they had before the
+	// description was added, so that existing state keeps matching
+	hash := resourceAwsSecurityGroupRuleHash(rule)
+	if expected := hashcode.String("22-22-tcp-false-10.0.0.0/16-"); hash != expected {
+		t.Fatalf("Expected the hash of a rule without a description to be %d, got %d", expected, hash)
+	}
+
+	rule["description"] = ""
+	if v := resourceAwsSecurityGroupRuleHash(rule); v != hash {
+		t.Fatalf("Expected an empty description not to change the hash, got %d, expected %d", v, hash)
+	}
+
+	rule["description"] = "SSH"
+	if v := resourceAwsSecurityGroupRuleHash(rule); v == hash {
+		t.Fatalf("Expected the description to change the hash")
+	}
+	if v := resourceAwsSecurityGroupRuleHashWithoutDescription(rule); v != hash {
+		t.Fatalf("Expected the hash without description to be %d, got %d", hash, v)
+	}
+}
+
+func TestResourceAwsSecurityGroupSplitRuleChanges(t *testing.T) {
+	rule := func(cidr, desc string) map[string]interface{} {
+		return map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   22,
+			"to_port":     22,
+			"self":        false,
+			"cidr_blocks": []interface{}{cidr},
+			"description": desc,
+		}
+	}
+
+	os := schema.NewSet(resourceAwsSecurityGroupRuleHash, []interface{}{
+		rule("10.0.0.0/16", ""),
+		rule("10.1.0.0/16", "Office"),
+		rule("10.2.0.0/16", "VPN"),
+	})
+	ns := schema.NewSet(resourceAwsSecurityGroupRuleHash, []interface{}{
+		rule("10.0.0.0/16", "Office"),
+		rule("10.1.0.0/16", "Office"),
+		rule("10.3.0.0/16", "VPN"),
+	})
+
+	remove, add, update := resourceAwsSecurityGroupSplitRuleChanges(os, ns)
+
+	if expected := []interface{}{rule("10.2.0.0/16", "VPN")}; !reflect.DeepEqual(remove, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", remove, expected)
+	}
+	if expected := []interface{}{rule("10.3.0.0/16", "VPN")}; !reflect.DeepEqual(add, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", add, expected)
+	}
+	if expected := []interface{}{rule("10.0.0.0/16", "Office")}; !reflect.DeepEqual(update, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", update, expected)
+	}
+}
+
 func TestAccAWSSecurityGroup_basic(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -1030,6 +1234,72 @@ func TestAccAWSSecurityGroup_ipv4andipv6Egress(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_ruleDescription(t *testing.T) {
+	var group ec2.SecurityGroup
+	var groupId string
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfigRuleDescription(rInt, "Office", "Outbound"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &group),
+					func(*terraform.State) error {
+						groupId = *group.GroupId
+						return nil
+					},
+					testAccCheckAWSSecurityGroupRuleDescriptions(&group, "Office", "Outbound"),
+					resource.TestCheckResourceAttr("aws_security_group.web", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group.web", "egress.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupConfigRuleDescription(rInt, "Office and VPN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &group),
+					func(*terraform.State) error {
+						if *group.GroupId != groupId {
+							return fmt.Errorf("Expected the security group %s to be updated in place, got %s",
+								groupId, *group.GroupId)
+						}
+						return nil
+					},
+					testAccCheckAWSSecurityGroupRuleDescriptions(&group, "Office and VPN", ""),
+					resource.TestCheckResourceAttr("aws_security_group.web", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group.web", "egress.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAWSSecurityGroupRuleDescriptions checks the descriptions of
+// all the CIDR blocks of the ingress and egress rules of the group
+func testAccCheckAWSSecurityGroupRuleDescriptions(group *ec2.SecurityGroup, ingress, egress string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		check := func(ruleType, expected string, perms []*ec2.IpPermission) error {
+			for _, perm := range perms {
+				for _, r := range perm.IpRanges {
+					if aws.StringValue(r.Description) != expected {
+						return fmt.Errorf("Expected the description of %s rule %s to be %q, got %q",
+							ruleType, *r.CidrIp, expected, aws.StringValue(r.Description))
+					}
+				}
+			}
+			return nil
+		}
+
+		if err := check("ingress", ingress, group.IpPermissions); err != nil {
+			return err
+		}
+		return check("egress", egress, group.IpPermissionsEgress)
+	}
+}
+
 func testAccCheckAWSSecurityGroupSGandCidrAttributes(group *ec2.SecurityGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *group.GroupName != "terraform_acceptance_test_example" {
@@ -2179,3 +2449,114 @@ resource "aws_security_group" "egress" {
     }
 }
 `
+
+func testAccAWSSecurityGroupConfigRuleDescription(rInt int, ingress, egress string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "tf-acc-test-%[1]d"
+  }
+}
+
+resource "aws_security_group" "web" {
+  name = "tf-acc-test-%[1]d"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+
+  ingress {
+    protocol = "tcp"
+    from_port = 22
+    to_port = 22
+    cidr_blocks = ["10.0.0.0/16", "10.2.0.0/16"]
+    description = "%[2]s"
+  }
+
+  egress {
+    protocol = "tcp"
+    from_port = 443
+    to_port = 443
+    cidr_blocks = ["0.0.0.0/0"]
+    description = "%[3]s"
+  }
+}
+`, rInt, ingress, egress)
+}
+
+func TestResourceAWSSecurityGroup_mockBasic(t *testing.T) {
+	m := newAwsMock(t, "security_group_basic")
+	defer m.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    m.Providers(),
+		CheckDestroy: testAccCheckAWSSecurityGroupMockDestroy(m),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfigMock(`Name = "tf-mock-sg"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_security_group.web", "id", "sg-1a2b3c4d"),
+					resource.TestCheckResourceAttr("aws_security_group.web", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group.web", "egress.#", "0"),
+					resource.TestCheckResourceAttr("aws_security_group.web", "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupConfigMock(`Name = "tf-mock-sg"
+    Environment = "test"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_security_group.web", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_security_group.web", "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupMockDestroy(m *awsMock) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := m.Meta().ec2conn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_security_group" {
+				continue
+			}
+
+			_, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+				GroupIds: []*string{aws.String(rs.Primary.ID)},
+			})
+			if err == nil {
+				return fmt.Errorf("Security Group (%s) still exists.", rs.Primary.ID)
+			}
+			if !isAWSErr(err, "InvalidGroup.NotFound", "") {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSecurityGroupConfigMock(tags string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_security_group" "web" {
+  name   = "tf-mock-sg"
+  vpc_id = "vpc-12345678"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 80
+    to_port     = 80
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+
+  tags {
+    %s
+  }
+}
+`, tags)
+}
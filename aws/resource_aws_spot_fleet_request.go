@@ -50,9 +50,10 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 			// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetLaunchSpecification
 			// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetLaunchSpecification.html
 			"launch_specification": {
-				Type:     schema.TypeSet,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_template_config"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"vpc_security_group_ids": {
@@ -260,6 +261,79 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 				},
 				Set: hashLaunchSpecification,
 			},
+			"launch_template_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_specification"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_template_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "$Default",
+										ForceNew:     true,
+										ValidateFunc: validateLaunchTemplateVersion,
+									},
+								},
+							},
+						},
+						"overrides": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"spot_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"weighted_capacity": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			// Everything on a spot fleet is ForceNew except target_capacity
 			"target_capacity": {
 				Type:     schema.TypeInt,
@@ -534,19 +608,90 @@ func buildAwsSpotFleetLaunchSpecifications(
 	return specs, nil
 }
 
+func expandSpotFleetLaunchTemplateConfigs(configs []interface{}) ([]*ec2.LaunchTemplateConfig, error) {
+	result := make([]*ec2.LaunchTemplateConfig, 0, len(configs))
+	for _, c := range configs {
+		config := c.(map[string]interface{})
+
+		spec, err := expandEc2LaunchTemplateSpecification(config["launch_template_specification"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		ltc := &ec2.LaunchTemplateConfig{
+			LaunchTemplateSpecification: &ec2.FleetLaunchTemplateSpecification{
+				LaunchTemplateId:   spec.LaunchTemplateId,
+				LaunchTemplateName: spec.LaunchTemplateName,
+				Version:            spec.Version,
+			},
+		}
+
+		for _, o := range config["overrides"].(*schema.Set).List() {
+			override := o.(map[string]interface{})
+			lto := &ec2.LaunchTemplateOverrides{}
+			if v := override["availability_zone"].(string); v != "" {
+				lto.AvailabilityZone = aws.String(v)
+			}
+			if v := override["instance_type"].(string); v != "" {
+				lto.InstanceType = aws.String(v)
+			}
+			if v := override["spot_price"].(string); v != "" {
+				lto.SpotPrice = aws.String(v)
+			}
+			if v := override["subnet_id"].(string); v != "" {
+				lto.SubnetId = aws.String(v)
+			}
+			if v := override["weighted_capacity"].(float64); v > 0 {
+				lto.WeightedCapacity = aws.Float64(v)
+			}
+			ltc.Overrides = append(ltc.Overrides, lto)
+		}
+
+		result = append(result, ltc)
+	}
+
+	return result, nil
+}
+
+func flattenSpotFleetLaunchTemplateConfigs(configs []*ec2.LaunchTemplateConfig) []interface{} {
+	result := make([]interface{}, 0, len(configs))
+	for _, ltc := range configs {
+		config := make(map[string]interface{})
+
+		if spec := ltc.LaunchTemplateSpecification; spec != nil {
+			config["launch_template_specification"] = []interface{}{
+				map[string]interface{}{
+					"id":      aws.StringValue(spec.LaunchTemplateId),
+					"name":    aws.StringValue(spec.LaunchTemplateName),
+					"version": aws.StringValue(spec.Version),
+				},
+			}
+		}
+
+		overrides := make([]interface{}, 0, len(ltc.Overrides))
+		for _, lto := range ltc.Overrides {
+			overrides = append(overrides, map[string]interface{}{
+				"availability_zone": aws.StringValue(lto.AvailabilityZone),
+				"instance_type":     aws.StringValue(lto.InstanceType),
+				"spot_price":        aws.StringValue(lto.SpotPrice),
+				"subnet_id":         aws.StringValue(lto.SubnetId),
+				"weighted_capacity": aws.Float64Value(lto.WeightedCapacity),
+			})
+		}
+		config["overrides"] = overrides
+
+		result = append(result, config)
+	}
+
+	return result
+}
+
 func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{}) error {
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RequestSpotFleet.html
 	conn := meta.(*AWSClient).ec2conn
 
-	launch_specs, err := buildAwsSpotFleetLaunchSpecifications(d, meta)
-	if err != nil {
-		return err
-	}
-
 	// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetRequestConfigData
 	spotFleetConfig := &ec2.SpotFleetRequestConfigData{
 		IamFleetRole:                     aws.String(d.Get("iam_fleet_role").(string)),
-		LaunchSpecifications:             launch_specs,
 		SpotPrice:                        aws.String(d.Get("spot_price").(string)),
 		TargetCapacity:                   aws.Int64(int64(d.Get("target_capacity").(int))),
 		ClientToken:                      aws.String(resource.UniqueId()),
@@ -554,6 +699,22 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 		ReplaceUnhealthyInstances:        aws.Bool(d.Get("replace_unhealthy_instances").(bool)),
 	}
 
+	if _, ok := d.GetOk("launch_specification"); ok {
+		launch_specs, err := buildAwsSpotFleetLaunchSpecifications(d, meta)
+		if err != nil {
+			return err
+		}
+		spotFleetConfig.LaunchSpecifications = launch_specs
+	} else if v, ok := d.GetOk("launch_template_config"); ok {
+		launchTemplateConfigs, err := expandSpotFleetLaunchTemplateConfigs(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+		spotFleetConfig.LaunchTemplateConfigs = launchTemplateConfigs
+	} else {
+		return fmt.Errorf("One of launch_specification or launch_template_config must be set for a spot fleet request")
+	}
+
 	if v, ok := d.GetOk("excess_capacity_termination_policy"); ok {
 		spotFleetConfig.ExcessCapacityTerminationPolicy = aws.String(v.(string))
 	}
@@ -594,7 +755,7 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 	// Since IAM is eventually consistent, we retry creation as a newly created role may not
 	// take effect immediately, resulting in an InvalidSpotFleetRequestConfig error
 	var resp *ec2.RequestSpotFleetOutput
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
 		var err error
 		resp, err = conn.RequestSpotFleet(spotFleetOpts)
 
@@ -789,6 +950,9 @@ func resourceAwsSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) e
 
 	d.Set("replace_unhealthy_instances", config.ReplaceUnhealthyInstances)
 	d.Set("launch_specification", launchSpecsToSet(config.LaunchSpecifications, conn))
+	if err := d.Set("launch_template_config", flattenSpotFleetLaunchTemplateConfigs(config.LaunchTemplateConfigs)); err != nil {
+		return fmt.Errorf("Error setting launch_template_config: %s", err)
+	}
 
 	return nil
 }
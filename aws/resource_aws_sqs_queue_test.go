@@ -561,3 +561,66 @@ resource "aws_sqs_queue" "queue" {
 }
 `, queue)
 }
+
+func TestResourceAWSSQSQueue_mockBasic(t *testing.T) {
+	m := newAwsMock(t, "sqs_queue_basic")
+	defer m.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    m.Providers(),
+		CheckDestroy: testAccCheckAWSSQSQueueMockDestroy(m),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSConfigMock(30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "id", "https://sqs.us-west-2.amazonaws.com/123456789012/tf-mock-queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "arn", "arn:aws:sqs:us-west-2:123456789012:tf-mock-queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "visibility_timeout_seconds", "30"),
+				),
+			},
+			{
+				Config: testAccAWSSQSConfigMock(60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "visibility_timeout_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSQSQueueMockDestroy(m *awsMock) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := m.Meta().sqsconn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue" {
+				continue
+			}
+
+			_, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+				QueueUrl: aws.String(rs.Primary.ID),
+			})
+			if err == nil {
+				return fmt.Errorf("Queue %s still exists. Failing!", rs.Primary.ID)
+			}
+			if !isAWSErr(err, "AWS.SimpleQueueService.NonExistentQueue", "") {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSQSConfigMock(visibilityTimeout int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_sqs_queue" "queue" {
+  name                       = "tf-mock-queue"
+  visibility_timeout_seconds = %d
+}
+`, visibilityTimeout)
+}
@@ -16,6 +16,9 @@ func resourceAwsSsmAssociation() *schema.Resource {
 		Read:   resourceAwsSsmAssociationRead,
 		Update: resourceAwsSsmAssocationUpdate,
 		Delete: resourceAwsSsmAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		MigrateState:  resourceAwsSsmAssociationMigrateState,
 		SchemaVersion: 1,
@@ -25,6 +25,9 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Read:   resourceAwsSsmDocumentRead,
 		Update: resourceAwsSsmDocumentUpdate,
 		Delete: resourceAwsSsmDocumentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -174,7 +177,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Reading SSM Document: %s", d.Id())
 
 	docInput := &ssm.DescribeDocumentInput{
-		Name: aws.String(d.Get("name").(string)),
+		Name: aws.String(d.Id()),
 	}
 
 	resp, err := ssmconn.DescribeDocument(docInput)
@@ -193,9 +196,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("description", doc.Description)
 	d.Set("schema_version", doc.SchemaVersion)
 
-	if _, ok := d.GetOk("document_type"); ok {
-		d.Set("document_type", doc.DocumentType)
-	}
+	d.Set("document_type", doc.DocumentType)
 
 	d.Set("document_version", doc.DocumentVersion)
 	d.Set("hash", doc.Hash)
@@ -210,6 +211,15 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("status", doc.Status)
 
+	contentResp, err := ssmconn.GetDocument(&ssm.GetDocumentInput{
+		Name:            doc.Name,
+		DocumentVersion: doc.DefaultVersion,
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error reading SSM document content: {{err}}", err)
+	}
+	d.Set("content", contentResp.Content)
+
 	gp, err := getDocumentPermissions(d, meta)
 
 	if err != nil {
@@ -251,9 +261,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func flattenAwsSsmDocumentArn(meta interface{}, docName *string) string {
-	region := meta.(*AWSClient).region
-
-	return fmt.Sprintf("arn:aws:ssm:%s::document/%s", region, *docName)
+	return arnString(meta.(*AWSClient).partition, "ssm", meta.(*AWSClient).region, "", "document/"+*docName)
 }
 
 func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -371,7 +379,7 @@ func getDocumentPermissions(d *schema.ResourceData, meta interface{}) (map[strin
 	permissionType := "Share"
 
 	permInput := &ssm.DescribeDocumentPermissionInput{
-		Name:           aws.String(d.Get("name").(string)),
+		Name:           aws.String(d.Id()),
 		PermissionType: aws.String(permissionType),
 	}
 
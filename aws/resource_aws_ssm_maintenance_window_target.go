@@ -14,6 +14,9 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 		Create: resourceAwsSsmMaintenanceWindowTargetCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTargetRead,
 		Delete: resourceAwsSsmMaintenanceWindowTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -141,3 +144,15 @@ func resourceAwsSsmMaintenanceWindowTargetDelete(d *schema.ResourceData, meta in
 
 	return nil
 }
+
+func resourceAwsSsmMaintenanceWindowTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "WINDOW-ID/WINDOW-TARGET-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("window_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -14,6 +14,9 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 		Create: resourceAwsSsmMaintenanceWindowTaskCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTaskRead,
 		Delete: resourceAwsSsmMaintenanceWindowTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTaskImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -285,3 +288,15 @@ func resourceAwsSsmMaintenanceWindowTaskDelete(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+func resourceAwsSsmMaintenanceWindowTaskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitImportId(d.Id(), "WINDOW-ID/WINDOW-TASK-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("window_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -15,6 +15,9 @@ func resourceAwsSsmParameter() *schema.Resource {
 		Read:   resourceAwsSsmParameterRead,
 		Update: resourceAwsSsmParameterPut,
 		Delete: resourceAwsSsmParameterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -36,6 +39,7 @@ func resourceAwsSsmParameter() *schema.Resource {
 			"key_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"overwrite": {
@@ -54,7 +58,7 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 
 	paramInput := &ssm.GetParametersInput{
 		Names: []*string{
-			aws.String(d.Get("name").(string)),
+			aws.String(d.Id()),
 		},
 		WithDecryption: aws.Bool(true),
 	}
@@ -76,6 +80,28 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("type", param.Type)
 	d.Set("value", param.Value)
 
+	// The KMS key encrypting SecureString values is only part of the
+	// parameter metadata.
+	describeInput := &ssm.DescribeParametersInput{
+		Filters: []*ssm.ParametersFilter{
+			{
+				Key:    aws.String(ssm.ParametersFilterKeyName),
+				Values: []*string{param.Name},
+			},
+		},
+	}
+
+	describeResp, err := ssmconn.DescribeParameters(describeInput)
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error describing SSM parameter metadata: {{err}}", err)
+	}
+
+	for _, metadata := range describeResp.Parameters {
+		if aws.StringValue(metadata.Name) == aws.StringValue(param.Name) {
+			d.Set("key_id", metadata.KeyId)
+		}
+	}
+
 	return nil
 }
 
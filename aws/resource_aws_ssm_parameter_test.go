@@ -114,6 +114,7 @@ func TestAccAWSSSMParameter_secure(t *testing.T) {
 					testAccCheckAWSSSMParameterExists("aws_ssm_parameter.secret_foo", &param),
 					resource.TestCheckResourceAttr("aws_ssm_parameter.secret_foo", "value", "secret"),
 					resource.TestCheckResourceAttr("aws_ssm_parameter.secret_foo", "type", "SecureString"),
+					resource.TestCheckResourceAttr("aws_ssm_parameter.secret_foo", "key_id", "alias/aws/ssm"),
 				),
 			},
 		},
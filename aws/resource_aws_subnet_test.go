@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +12,51 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+		Name:         "aws_subnet",
+		Dependencies: []string{"aws_instance", "aws_nat_gateway", "aws_lambda_function"},
+		F:            testSweepSubnets,
+	})
+}
+
+func testSweepSubnets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	vpcIds, err := sweeperVpcIds(conn)
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{})
+	if err != nil {
+		return fmt.Errorf("Error describing subnets in Sweeper: %s", err)
+	}
+
+	swept := 0
+	for _, subnet := range resp.Subnets {
+		if !hasSweeperNamePrefix(sweeperEc2NameTag(subnet.Tags)) && !vpcIds[aws.StringValue(subnet.VpcId)] {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting subnet %s", *subnet.SubnetId)
+		if err := testSweepResource(resourceAwsSubnet(), *subnet.SubnetId, nil, client); err != nil {
+			return fmt.Errorf("Error deleting subnet (%s) in Sweeper: %s", *subnet.SubnetId, err)
+		}
+		swept++
+	}
+
+	if swept == 0 {
+		log.Print("[DEBUG] No aws subnets to sweep")
+	}
+
+	return nil
+}
+
 func TestAccAWSSubnet_basic(t *testing.T) {
 	var v ec2.Subnet
 
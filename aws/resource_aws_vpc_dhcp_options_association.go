@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,9 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 		Read:   resourceAwsVpcDhcpOptionsAssociationRead,
 		Update: resourceAwsVpcDhcpOptionsAssociationUpdate,
 		Delete: resourceAwsVpcDhcpOptionsAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcDhcpOptionsAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -97,3 +101,24 @@ func resourceAwsVpcDhcpOptionsAssociationDelete(d *schema.ResourceData, meta int
 	d.SetId("")
 	return nil
 }
+
+// The DHCP options associations are imported using the VPC ID.
+func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+	vpcId := d.Id()
+
+	vpcRaw, _, err := VPCStateRefreshFunc(conn, vpcId)()
+	if err != nil {
+		return nil, err
+	}
+	if vpcRaw == nil {
+		return nil, fmt.Errorf("VPC %s not found", vpcId)
+	}
+
+	optsId := aws.StringValue(vpcRaw.(*ec2.Vpc).DhcpOptionsId)
+	d.Set("vpc_id", vpcId)
+	d.Set("dhcp_options_id", optsId)
+	d.SetId(optsId + "-" + vpcId)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcIpv4CidrBlockAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpv4CidrBlockAssociationCreate,
+		Read:   resourceAwsVpcIpv4CidrBlockAssociationRead,
+		Delete: resourceAwsVpcIpv4CidrBlockAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.AssociateVpcCidrBlockInput{
+		VpcId:     aws.String(d.Get("vpc_id").(string)),
+		CidrBlock: aws.String(d.Get("cidr_block").(string)),
+	}
+	log.Printf("[DEBUG] Creating VPC IPv4 CIDR block association: %#v", req)
+	resp, err := conn.AssociateVpcCidrBlock(req)
+	if err != nil {
+		return fmt.Errorf("Error creating VPC IPv4 CIDR block association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.CidrBlockAssociation.AssociationId))
+
+	log.Printf("[DEBUG] Waiting for VPC IPv4 CIDR block association (%s) to become associated", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeAssociating},
+		Target:     []string{ec2.VpcCidrBlockStateCodeAssociated},
+		Refresh:    vpcIpv4CidrBlockAssociationStateRefreshFunc(conn, d.Get("vpc_id").(string), d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC IPv4 CIDR block association (%s) to become associated: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpv4CidrBlockAssociationRead(d, meta)
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// The association ID is enough to find the VPC, which allows importing
+	// the association by its ID alone
+	resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"cidr-block-association.association-id": d.Id(),
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading VPC IPv4 CIDR block association (%s): %s", d.Id(), err)
+	}
+
+	var assoc *ec2.VpcCidrBlockAssociation
+	var vpcId string
+	for _, vpc := range resp.Vpcs {
+		for _, a := range vpc.CidrBlockAssociationSet {
+			if aws.StringValue(a.AssociationId) == d.Id() {
+				assoc = a
+				vpcId = aws.StringValue(vpc.VpcId)
+			}
+		}
+	}
+
+	if assoc == nil || vpcIpv4CidrBlockAssociationIsGone(assoc) {
+		log.Printf("[WARN] VPC IPv4 CIDR block association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_id", vpcId)
+	d.Set("cidr_block", assoc.CidrBlock)
+
+	return nil
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting VPC IPv4 CIDR block association: %s", d.Id())
+	_, err := conn.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{
+		AssociationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcID.NotFound", "") || isAWSErr(err, "InvalidVpcCidrBlockAssociationID.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPC IPv4 CIDR block association (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Waiting for VPC IPv4 CIDR block association (%s) to become disassociated", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeAssociated, ec2.VpcCidrBlockStateCodeDisassociating},
+		Target:     []string{},
+		Refresh:    vpcIpv4CidrBlockAssociationStateRefreshFunc(conn, d.Get("vpc_id").(string), d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC IPv4 CIDR block association (%s) to become disassociated: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// vpcIpv4CidrBlockAssociationStateRefreshFunc returns a
+// resource.StateRefreshFunc that is used to watch the state of an IPv4 CIDR
+// block association of a VPC. Disassociated blocks are still listed by the API
+// for a while, so they are reported as not found.
+func vpcIpv4CidrBlockAssociationStateRefreshFunc(conn *ec2.EC2, vpcId, assocId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vpcRaw, _, err := VPCStateRefreshFunc(conn, vpcId)()
+		if err != nil {
+			return nil, "", err
+		}
+		if vpcRaw == nil {
+			return nil, "", nil
+		}
+
+		for _, a := range vpcRaw.(*ec2.Vpc).CidrBlockAssociationSet {
+			if aws.StringValue(a.AssociationId) != assocId {
+				continue
+			}
+			if vpcIpv4CidrBlockAssociationIsGone(a) {
+				return nil, "", nil
+			}
+
+			state := aws.StringValue(a.CidrBlockState.State)
+			if state == ec2.VpcCidrBlockStateCodeFailed {
+				return a, state, fmt.Errorf("%s", aws.StringValue(a.CidrBlockState.StatusMessage))
+			}
+			return a, state, nil
+		}
+
+		return nil, "", nil
+	}
+}
+
+func vpcIpv4CidrBlockAssociationIsGone(a *ec2.VpcCidrBlockAssociation) bool {
+	return a.CidrBlockState != nil && aws.StringValue(a.CidrBlockState.State) == ec2.VpcCidrBlockStateCodeDisassociated
+}
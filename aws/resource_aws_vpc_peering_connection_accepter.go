@@ -54,6 +54,11 @@ func resourceAwsVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{
 	id := d.Get("vpc_peering_connection_id").(string)
 	d.SetId(id)
 
+	// Reading the connection replaces the configured tags with the ones it
+	// already has, so keep them for setTags to apply along with the
+	// provider's default tags.
+	tags := d.Get("tags").(map[string]interface{})
+
 	if err := resourceAwsVPCPeeringRead(d, meta); err != nil {
 		return err
 	}
@@ -66,6 +71,10 @@ func resourceAwsVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{
 		return errors.New("aws_vpc_peering_connection_accepter can only adopt into management cross-account VPC peering connections")
 	}
 
+	if err := d.Set("tags", tags); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCPeeringUpdate(d, meta)
 }
 
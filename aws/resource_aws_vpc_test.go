@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +12,46 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+		Name: "aws_vpc",
+		Dependencies: []string{
+			"aws_internet_gateway",
+			"aws_nat_gateway",
+			"aws_security_group",
+			"aws_subnet",
+		},
+		F: testSweepVPCs,
+	})
+}
+
+func testSweepVPCs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	vpcIds, err := sweeperVpcIds(conn)
+	if err != nil {
+		return err
+	}
+
+	if len(vpcIds) == 0 {
+		log.Print("[DEBUG] No aws VPCs to sweep")
+		return nil
+	}
+
+	for id := range vpcIds {
+		log.Printf("[INFO] Deleting VPC %s", id)
+		if err := testSweepResource(resourceAwsVpc(), id, nil, client); err != nil {
+			return fmt.Errorf("Error deleting VPC (%s) in Sweeper: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAWSVpc_basic(t *testing.T) {
 	var vpc ec2.Vpc
 
@@ -30,6 +71,12 @@ func TestAccAWSVpc_basic(t *testing.T) {
 						"aws_vpc.foo", "default_route_table_id"),
 					resource.TestCheckResourceAttr(
 						"aws_vpc.foo", "enable_dns_support", "true"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc.foo", "cidr_block_associations.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc.foo", "cidr_block_associations.0.cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc.foo", "cidr_block_associations.0.state", "associated"),
 				),
 			},
 		},
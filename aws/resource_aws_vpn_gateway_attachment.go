@@ -18,6 +18,9 @@ func resourceAwsVpnGatewayAttachment() *schema.Resource {
 		Create: resourceAwsVpnGatewayAttachmentCreate,
 		Read:   resourceAwsVpnGatewayAttachmentRead,
 		Delete: resourceAwsVpnGatewayAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnGatewayAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -208,3 +211,31 @@ func vpnGatewayAttachmentStateRefresh(conn *ec2.EC2, vpcId, vgwId string) resour
 func vpnGatewayAttachmentId(vpcId, vgwId string) string {
 	return fmt.Sprintf("vpn-attachment-%x", hashcode.String(fmt.Sprintf("%s-%s", vpcId, vgwId)))
 }
+
+// The VPN gateway attachments are imported using the VPN gateway ID, as a
+// VPN gateway can only be attached to one VPC.
+func resourceAwsVpnGatewayAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+	vgwId := d.Id()
+
+	resp, err := conn.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{
+		VpnGatewayIds: []*string{aws.String(vgwId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.VpnGateways) == 0 {
+		return nil, fmt.Errorf("VPN Gateway %q not found", vgwId)
+	}
+
+	vga := vpnGatewayGetAttachment(resp.VpnGateways[0])
+	if *vga.State != "attached" {
+		return nil, fmt.Errorf("VPN Gateway %q is not attached to a VPC", vgwId)
+	}
+
+	d.Set("vpn_gateway_id", vgwId)
+	d.Set("vpc_id", vga.VpcId)
+	d.SetId(vpnGatewayAttachmentId(*vga.VpcId, vgwId))
+
+	return []*schema.ResourceData{d}, nil
+}
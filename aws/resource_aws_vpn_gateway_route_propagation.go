@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -14,6 +15,9 @@ func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
 		Create: resourceAwsVpnGatewayRoutePropagationEnable,
 		Read:   resourceAwsVpnGatewayRoutePropagationRead,
 		Delete: resourceAwsVpnGatewayRoutePropagationDisable,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnGatewayRoutePropagationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": &schema.Schema{
@@ -100,3 +104,17 @@ func resourceAwsVpnGatewayRoutePropagationRead(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+// The route propagations are imported using their ID, which is the VPN
+// gateway ID and the route table ID joined by an underscore.
+func resourceAwsVpnGatewayRoutePropagationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPN-GATEWAY-ID_ROUTE-TABLE-ID", d.Id())
+	}
+
+	d.Set("vpn_gateway_id", parts[0])
+	d.Set("route_table_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
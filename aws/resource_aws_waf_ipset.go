@@ -16,6 +16,9 @@ func resourceAwsWafIPSet() *schema.Resource {
 		Read:   resourceAwsWafIPSetRead,
 		Update: resourceAwsWafIPSetUpdate,
 		Delete: resourceAwsWafIPSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
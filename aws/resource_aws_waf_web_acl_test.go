@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -155,6 +156,39 @@ func TestAccAWSWafWebAcl_disappears(t *testing.T) {
 	})
 }
 
+func TestFlattenWafActivatedRules(t *testing.T) {
+	rules := []*waf.ActivatedRule{
+		{
+			Action:   &waf.WafAction{Type: aws.String("BLOCK")},
+			Priority: aws.Int64(1),
+			RuleId:   aws.String("rule-1"),
+			Type:     aws.String("REGULAR"),
+		},
+		{
+			Priority: aws.Int64(2),
+			RuleId:   aws.String("rule-2"),
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"action":   []interface{}{map[string]interface{}{"type": "BLOCK"}},
+			"priority": 1,
+			"rule_id":  "rule-1",
+			"type":     "REGULAR",
+		},
+		map[string]interface{}{
+			"priority": 2,
+			"rule_id":  "rule-2",
+			"type":     "",
+		},
+	}
+
+	if result := flattenWafActivatedRules(rules); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+}
+
 func testAccCheckAWSWafWebAclDisappears(v *waf.WebACL) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).wafconn
@@ -17,6 +17,9 @@ func resourceAwsWafRegionalByteMatchSet() *schema.Resource {
 		Read:   resourceAwsWafRegionalByteMatchSetRead,
 		Update: resourceAwsWafRegionalByteMatchSetUpdate,
 		Delete: resourceAwsWafRegionalByteMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -137,7 +140,7 @@ func flattenWafByteMatchTuplesWR(in []*waf.ByteMatchTuple) []interface{} {
 		tuple := map[string]interface{}{
 			"field_to_match":        ms,
 			"positional_constraint": *tuple.PositionalConstraint,
-			"target_string":         tuple.TargetString,
+			"target_string":         string(tuple.TargetString),
 			"text_transformation":   *tuple.TextTransformation,
 		}
 		tuples[i] = tuple
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -12,92 +11,94 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
-					Bucket: aws.String(d.Get("bucket").(string)),
-				})
-			})
-			if err != nil {
-				return err
-			}
+//
+// S3 replaces the whole tag set of a bucket on every update, so the full set
+// of configured tags is written, together with any tags on the bucket whose
+// keys are ignored through the provider configuration.
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
+	if !tagsNeedUpdate(d) {
+		return nil
+	}
+
+	o, n := getTagsChange(d, meta)
+	create, remove := diffKeyValueTags(newKeyValueTags(o), newKeyValueTags(n))
+	if len(create) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	bucket := d.Get("bucket").(string)
+	tags := newKeyValueTags(n).IgnoreAws()
+
+	current, err := getTagSetS3(conn, bucket)
+	if err != nil && !isAWSErr(err, "NoSuchBucket", "") {
+		return err
+	}
+	for k, v := range keyValueTagsFromS3(current).IgnoreAws() {
+		if tagKeyIgnored(meta, k) {
+			tags[k] = v
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			req := &s3.PutBucketTaggingInput{
-				Bucket: aws.String(d.Get("bucket").(string)),
-				Tagging: &s3.Tagging{
-					TagSet: create,
-				},
-			}
-
-			_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-				return conn.PutBucketTagging(req)
+	}
+
+	if len(tags) == 0 {
+		log.Printf("[DEBUG] Removing tags: %q from %s", remove.Keys(), bucket)
+		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
+				Bucket: aws.String(bucket),
 			})
-			if err != nil {
-				return err
-			}
-		}
+		})
+		return err
+	}
+
+	log.Printf("[DEBUG] Setting tags: %q for %s", tags.Keys(), bucket)
+	req := &s3.PutBucketTaggingInput{
+		Bucket: aws.String(bucket),
+		Tagging: &s3.Tagging{
+			TagSet: tags.S3Tags(),
+		},
 	}
 
-	return nil
+	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return conn.PutBucketTagging(req)
+	})
+	return err
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapS3(create), remove
+	create, remove := diffKeyValueTags(keyValueTagsFromS3(oldTags), keyValueTagsFromS3(newTags))
+	return create.S3Tags(), remove.S3Tags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
-	result := make([]*s3.Tag, 0, len(m))
-	for k, v := range m {
-		t := &s3.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredS3(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().S3Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapS3(ts []*s3.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsFromS3(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsFromS3 converts S3 tags to keyValueTags.
+func keyValueTagsFromS3(ts []*s3.Tag) keyValueTags {
+	tags := make(keyValueTags)
 	for _, t := range ts {
-		if !tagIgnoredS3(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// S3Tags returns the tags as S3 tags.
+func (tags keyValueTags) S3Tags() []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
@@ -125,13 +126,5 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredAws(aws.StringValue(t.Key))
 }
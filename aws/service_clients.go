@@ -0,0 +1,519 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+)
+
+// awsServiceClient describes one of the service clients of AWSClient.
+// The list of these drives the client creation in Config.Client() as well
+// as the arguments of the endpoints block of the provider.
+type awsServiceClient struct {
+	// Name is the argument in the endpoints block overriding the endpoint
+	// of the service.
+	Name string
+
+	// Fallback is the endpoints argument used when Name is not set, for
+	// services which used to share the endpoint of another one.
+	Fallback string
+
+	// Region, if set, is the region the client is always configured with,
+	// regardless of the region of the provider.
+	Region string
+
+	// New creates the client from its session and sets it on AWSClient.
+	New func(client *AWSClient, sess *session.Session)
+
+	// Copy replaces the client on AWSClient with a copy whose handlers are
+	// set up by addHandlers, leaving the original client unchanged.
+	Copy func(client *AWSClient, addHandlers func(*request.Handlers))
+}
+
+var awsServiceClients = []awsServiceClient{
+	{Name: "acm", New: func(client *AWSClient, sess *session.Session) {
+		client.acmconn = acm.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.acmconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.acmconn = &conn
+	}},
+	{Name: "apigateway", New: func(client *AWSClient, sess *session.Session) {
+		client.apigateway = apigateway.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.apigateway
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.apigateway = &conn
+	}},
+	{Name: "applicationautoscaling", New: func(client *AWSClient, sess *session.Session) {
+		client.appautoscalingconn = applicationautoscaling.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.appautoscalingconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.appautoscalingconn = &conn
+	}},
+	{Name: "autoscaling", New: func(client *AWSClient, sess *session.Session) {
+		client.autoscalingconn = autoscaling.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.autoscalingconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.autoscalingconn = &conn
+	}},
+	{Name: "cloudformation", New: func(client *AWSClient, sess *session.Session) {
+		client.cfconn = cloudformation.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.cfconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.cfconn = &conn
+	}},
+	{Name: "cloudfront", New: func(client *AWSClient, sess *session.Session) {
+		client.cloudfrontconn = cloudfront.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.cloudfrontconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.cloudfrontconn = &conn
+	}},
+	{Name: "cloudtrail", New: func(client *AWSClient, sess *session.Session) {
+		client.cloudtrailconn = cloudtrail.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.cloudtrailconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.cloudtrailconn = &conn
+	}},
+	{Name: "cloudwatch", New: func(client *AWSClient, sess *session.Session) {
+		client.cloudwatchconn = cloudwatch.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.cloudwatchconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.cloudwatchconn = &conn
+	}},
+	{Name: "cloudwatchevents", New: func(client *AWSClient, sess *session.Session) {
+		client.cloudwatcheventsconn = cloudwatchevents.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.cloudwatcheventsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.cloudwatcheventsconn = &conn
+	}},
+	{Name: "cloudwatchlogs", New: func(client *AWSClient, sess *session.Session) {
+		client.cloudwatchlogsconn = cloudwatchlogs.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.cloudwatchlogsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.cloudwatchlogsconn = &conn
+	}},
+	{Name: "codebuild", New: func(client *AWSClient, sess *session.Session) {
+		client.codebuildconn = codebuild.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.codebuildconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.codebuildconn = &conn
+	}},
+	{Name: "codecommit", New: func(client *AWSClient, sess *session.Session) {
+		client.codecommitconn = codecommit.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.codecommitconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.codecommitconn = &conn
+	}},
+	{Name: "codedeploy", New: func(client *AWSClient, sess *session.Session) {
+		client.codedeployconn = codedeploy.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.codedeployconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.codedeployconn = &conn
+	}},
+	{Name: "codepipeline", New: func(client *AWSClient, sess *session.Session) {
+		client.codepipelineconn = codepipeline.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.codepipelineconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.codepipelineconn = &conn
+	}},
+	{Name: "cognitoidentity", New: func(client *AWSClient, sess *session.Session) {
+		client.cognitoconn = cognitoidentity.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.cognitoconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.cognitoconn = &conn
+	}},
+	{Name: "configservice", New: func(client *AWSClient, sess *session.Session) {
+		client.configconn = configservice.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.configconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.configconn = &conn
+	}},
+	{Name: "devicefarm", New: func(client *AWSClient, sess *session.Session) {
+		client.devicefarmconn = devicefarm.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.devicefarmconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.devicefarmconn = &conn
+	}},
+	{Name: "dms", New: func(client *AWSClient, sess *session.Session) {
+		client.dmsconn = databasemigrationservice.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.dmsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.dmsconn = &conn
+	}},
+	{Name: "ds", New: func(client *AWSClient, sess *session.Session) {
+		client.dsconn = directoryservice.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.dsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.dsconn = &conn
+	}},
+	{Name: "dynamodb", New: func(client *AWSClient, sess *session.Session) {
+		client.dynamodbconn = dynamodb.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.dynamodbconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.dynamodbconn = &conn
+	}},
+	{Name: "ec2", New: func(client *AWSClient, sess *session.Session) {
+		client.ec2conn = ec2.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.ec2conn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.ec2conn = &conn
+	}},
+	{Name: "ecr", New: func(client *AWSClient, sess *session.Session) {
+		client.ecrconn = ecr.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.ecrconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.ecrconn = &conn
+	}},
+	{Name: "ecs", New: func(client *AWSClient, sess *session.Session) {
+		client.ecsconn = ecs.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.ecsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.ecsconn = &conn
+	}},
+	{Name: "efs", New: func(client *AWSClient, sess *session.Session) {
+		client.efsconn = efs.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.efsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.efsconn = &conn
+	}},
+	{Name: "elasticache", New: func(client *AWSClient, sess *session.Session) {
+		client.elasticacheconn = elasticache.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.elasticacheconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.elasticacheconn = &conn
+	}},
+	{Name: "elasticbeanstalk", New: func(client *AWSClient, sess *session.Session) {
+		client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.elasticbeanstalkconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.elasticbeanstalkconn = &conn
+	}},
+	{Name: "elastictranscoder", New: func(client *AWSClient, sess *session.Session) {
+		client.elastictranscoderconn = elastictranscoder.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.elastictranscoderconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.elastictranscoderconn = &conn
+	}},
+	{Name: "elb", New: func(client *AWSClient, sess *session.Session) {
+		client.elbconn = elb.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.elbconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.elbconn = &conn
+	}},
+	{Name: "elbv2", Fallback: "elb", New: func(client *AWSClient, sess *session.Session) {
+		client.elbv2conn = elbv2.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.elbv2conn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.elbv2conn = &conn
+	}},
+	{Name: "emr", New: func(client *AWSClient, sess *session.Session) {
+		client.emrconn = emr.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.emrconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.emrconn = &conn
+	}},
+	{Name: "es", New: func(client *AWSClient, sess *session.Session) {
+		client.esconn = elasticsearch.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.esconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.esconn = &conn
+	}},
+	{Name: "firehose", New: func(client *AWSClient, sess *session.Session) {
+		client.firehoseconn = firehose.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.firehoseconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.firehoseconn = &conn
+	}},
+	{Name: "glacier", New: func(client *AWSClient, sess *session.Session) {
+		client.glacierconn = glacier.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.glacierconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.glacierconn = &conn
+	}},
+	{Name: "iam", New: func(client *AWSClient, sess *session.Session) {
+		client.iamconn = iam.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.iamconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.iamconn = &conn
+	}},
+	{Name: "inspector", New: func(client *AWSClient, sess *session.Session) {
+		client.inspectorconn = inspector.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.inspectorconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.inspectorconn = &conn
+	}},
+	{Name: "iot", New: func(client *AWSClient, sess *session.Session) {
+		client.iotconn = iot.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.iotconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.iotconn = &conn
+	}},
+	{Name: "kinesis", New: func(client *AWSClient, sess *session.Session) {
+		client.kinesisconn = kinesis.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.kinesisconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.kinesisconn = &conn
+	}},
+	{Name: "kms", New: func(client *AWSClient, sess *session.Session) {
+		client.kmsconn = kms.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.kmsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.kmsconn = &conn
+	}},
+	{Name: "lambda", New: func(client *AWSClient, sess *session.Session) {
+		client.lambdaconn = lambda.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.lambdaconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.lambdaconn = &conn
+	}},
+	{Name: "lightsail", New: func(client *AWSClient, sess *session.Session) {
+		client.lightsailconn = lightsail.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.lightsailconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.lightsailconn = &conn
+	}},
+	{Name: "opsworks", New: func(client *AWSClient, sess *session.Session) {
+		client.opsworksconn = opsworks.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.opsworksconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.opsworksconn = &conn
+	}},
+	{Name: "rds", New: func(client *AWSClient, sess *session.Session) {
+		client.rdsconn = rds.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.rdsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.rdsconn = &conn
+	}},
+	{Name: "redshift", New: func(client *AWSClient, sess *session.Session) {
+		client.redshiftconn = redshift.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.redshiftconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.redshiftconn = &conn
+	}},
+	// This restriction should only be used for Route53 sessions.
+	// Other resources that have restrictions should allow the API to fail, rather
+	// than Terraform abstracting the region for the user. This can lead to breaking
+	// changes if that resource is ever opened up to more regions.
+	{Name: "route53", Region: "us-east-1", New: func(client *AWSClient, sess *session.Session) {
+		client.r53conn = route53.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.r53conn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.r53conn = &conn
+	}},
+	{Name: "s3", New: func(client *AWSClient, sess *session.Session) {
+		client.s3conn = s3.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.s3conn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.s3conn = &conn
+	}},
+	{Name: "sdb", New: func(client *AWSClient, sess *session.Session) {
+		client.simpledbconn = simpledb.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.simpledbconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.simpledbconn = &conn
+	}},
+	{Name: "servicecatalog", New: func(client *AWSClient, sess *session.Session) {
+		client.scconn = servicecatalog.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.scconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.scconn = &conn
+	}},
+	{Name: "ses", New: func(client *AWSClient, sess *session.Session) {
+		client.sesConn = ses.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.sesConn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.sesConn = &conn
+	}},
+	{Name: "sfn", New: func(client *AWSClient, sess *session.Session) {
+		client.sfnconn = sfn.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.sfnconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.sfnconn = &conn
+	}},
+	{Name: "sns", New: func(client *AWSClient, sess *session.Session) {
+		client.snsconn = sns.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.snsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.snsconn = &conn
+	}},
+	{Name: "sqs", New: func(client *AWSClient, sess *session.Session) {
+		client.sqsconn = sqs.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.sqsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.sqsconn = &conn
+	}},
+	{Name: "ssm", New: func(client *AWSClient, sess *session.Session) {
+		client.ssmconn = ssm.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.ssmconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.ssmconn = &conn
+	}},
+	{Name: "sts", New: func(client *AWSClient, sess *session.Session) {
+		client.stsconn = sts.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.stsconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.stsconn = &conn
+	}},
+	{Name: "waf", New: func(client *AWSClient, sess *session.Session) {
+		client.wafconn = waf.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.wafconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.wafconn = &conn
+	}},
+	{Name: "wafregional", New: func(client *AWSClient, sess *session.Session) {
+		client.wafregionalconn = wafregional.New(sess)
+	}, Copy: func(client *AWSClient, addHandlers func(*request.Handlers)) {
+		conn := *client.wafregionalconn
+		conn.Client = copyServiceClient(conn.Client, addHandlers)
+		client.wafregionalconn = &conn
+	}},
+}
+
+// copyServiceClient returns a copy of the client whose handlers are set up
+// by addHandlers. The copy shares the configuration of the client, including
+// its rate limit.
+func copyServiceClient(c *client.Client, addHandlers func(*request.Handlers)) *client.Client {
+	copied := *c
+	copied.Handlers = c.Handlers.Copy()
+	addHandlers(&copied.Handlers)
+	return &copied
+}
+
+// serviceSession returns a copy of sess for the client of the service,
+// using the endpoint, retry and rate limit settings configured for the
+// service if any.
+func (c *Config) serviceSession(sess *session.Session, svc awsServiceClient) *session.Session {
+	config := &aws.Config{}
+
+	if c.MaxBackoff > 0 {
+		request.WithRetryer(config, maxBackoffRetryer{
+			DefaultRetryer: client.DefaultRetryer{NumMaxRetries: c.MaxRetries},
+			MaxBackoff:     c.MaxBackoff,
+		})
+	}
+
+	endpoint := c.Endpoints[svc.Name]
+	if endpoint == "" && svc.Fallback != "" {
+		endpoint = c.Endpoints[svc.Fallback]
+	}
+	if endpoint != "" {
+		config.Endpoint = aws.String(endpoint)
+	}
+	if svc.Region != "" {
+		config.Region = aws.String(svc.Region)
+	}
+
+	svcSess := sess.Copy(config)
+	c.addThrottlingHandlers(&svcSess.Handlers, svc)
+
+	return svcSess
+}
@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -10,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -175,6 +175,11 @@ func expandIPPerms(
 				*perm.FromPort, *perm.ToPort)
 		}
 
+		var description string
+		if v, ok := m["description"]; ok {
+			description = v.(string)
+		}
+
 		var groups []string
 		if raw, ok := m["security_groups"]; ok {
 			list := raw.(*schema.Set).List()
@@ -206,6 +211,10 @@ func expandIPPerms(
 					perm.UserIdGroupPairs[i].UserId = aws.String(ownerId)
 				}
 
+				if description != "" {
+					perm.UserIdGroupPairs[i].Description = aws.String(description)
+				}
+
 				if !vpc {
 					perm.UserIdGroupPairs[i].GroupId = nil
 					perm.UserIdGroupPairs[i].GroupName = aws.String(id)
@@ -216,20 +225,32 @@ func expandIPPerms(
 		if raw, ok := m["cidr_blocks"]; ok {
 			list := raw.([]interface{})
 			for _, v := range list {
-				perm.IpRanges = append(perm.IpRanges, &ec2.IpRange{CidrIp: aws.String(v.(string))})
+				ipRange := &ec2.IpRange{CidrIp: aws.String(v.(string))}
+				if description != "" {
+					ipRange.Description = aws.String(description)
+				}
+				perm.IpRanges = append(perm.IpRanges, ipRange)
 			}
 		}
 		if raw, ok := m["ipv6_cidr_blocks"]; ok {
 			list := raw.([]interface{})
 			for _, v := range list {
-				perm.Ipv6Ranges = append(perm.Ipv6Ranges, &ec2.Ipv6Range{CidrIpv6: aws.String(v.(string))})
+				ipv6Range := &ec2.Ipv6Range{CidrIpv6: aws.String(v.(string))}
+				if description != "" {
+					ipv6Range.Description = aws.String(description)
+				}
+				perm.Ipv6Ranges = append(perm.Ipv6Ranges, ipv6Range)
 			}
 		}
 
 		if raw, ok := m["prefix_list_ids"]; ok {
 			list := raw.([]interface{})
 			for _, v := range list {
-				perm.PrefixListIds = append(perm.PrefixListIds, &ec2.PrefixListId{PrefixListId: aws.String(v.(string))})
+				prefixListId := &ec2.PrefixListId{PrefixListId: aws.String(v.(string))}
+				if description != "" {
+					prefixListId.Description = aws.String(description)
+				}
+				perm.PrefixListIds = append(perm.PrefixListIds, prefixListId)
 			}
 		}
 
@@ -562,7 +583,7 @@ func flattenEcsVolumes(list []*ecs.Volume) []map[string]interface{} {
 			"name": *volume.Name,
 		}
 
-		if volume.Host.SourcePath != nil {
+		if volume.Host != nil && volume.Host.SourcePath != nil {
 			l["host_path"] = *volume.Host.SourcePath
 		}
 
@@ -593,15 +614,15 @@ func flattenEcsLoadBalancers(list []*ecs.LoadBalancer) []map[string]interface{}
 	return result
 }
 
-// Encodes an array of ecs.ContainerDefinitions into a JSON string
+// Encodes an array of ecs.ContainerDefinitions into a JSON string, using
+// the same keys as the JSON given in the configuration
 func flattenEcsContainerDefinitions(definitions []*ecs.ContainerDefinition) (string, error) {
-	byteArray, err := json.Marshal(definitions)
+	byteArray, err := jsonutil.BuildJSON(definitions)
 	if err != nil {
 		return "", fmt.Errorf("Error encoding to JSON: %s", err)
 	}
 
-	n := bytes.Index(byteArray, []byte{0})
-	return string(byteArray[:n]), nil
+	return string(byteArray), nil
 }
 
 // Flattens an array of Options into a []map[string]interface{}
@@ -1991,8 +2012,8 @@ func flattenApiGatewayUsagePlanQuota(s *apigateway.QuotaSettings) []map[string]i
 }
 
 func buildApiGatewayInvokeURL(restApiId, region, stageName string) string {
-	return fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com/%s",
-		restApiId, region, stageName)
+	return fmt.Sprintf("https://%s.execute-api.%s.%s/%s",
+		restApiId, region, regionDnsSuffix(region), stageName)
 }
 
 func buildApiGatewayExecutionARN(restApiId, region, accountId string) (string, error) {
@@ -2000,8 +2021,7 @@ func buildApiGatewayExecutionARN(restApiId, region, accountId string) (string, e
 		return "", fmt.Errorf("Unable to build execution ARN for %s as account ID is missing",
 			restApiId)
 	}
-	return fmt.Sprintf("arn:aws:execute-api:%s:%s:%s",
-		region, accountId, restApiId), nil
+	return arnString(regionPartition(region), "execute-api", region, accountId, restApiId), nil
 }
 
 func expandCognitoSupportedLoginProviders(config map[string]interface{}) map[string]*string {
@@ -2077,8 +2097,8 @@ func flattenCognitoIdentityProviders(ips []*cognitoidentity.Provider) []map[stri
 
 func buildLambdaInvokeArn(lambdaArn, region string) string {
 	apiVersion := "2015-03-31"
-	return fmt.Sprintf("arn:aws:apigateway:%s:lambda:path/%s/functions/%s/invocations",
-		region, apiVersion, lambdaArn)
+	return arnString(regionPartition(region), "apigateway", region, "lambda",
+		fmt.Sprintf("path/%s/functions/%s/invocations", apiVersion, lambdaArn))
 }
 
 func sliceContainsMap(l []interface{}, m map[string]interface{}) (int, bool) {
@@ -2127,6 +2147,27 @@ func flattenAwsSsmTargets(targets []*ssm.Target) []map[string]interface{} {
 	return result
 }
 
+// flattenVpcCidrBlockAssociations flattens the IPv4 CIDR blocks of a VPC,
+// including its primary block and leaving out the disassociated ones.
+func flattenVpcCidrBlockAssociations(assocs []*ec2.VpcCidrBlockAssociation) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(assocs))
+	for _, a := range assocs {
+		if vpcIpv4CidrBlockAssociationIsGone(a) {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"association_id": aws.StringValue(a.AssociationId),
+			"cidr_block":     aws.StringValue(a.CidrBlock),
+		}
+		if a.CidrBlockState != nil {
+			m["state"] = aws.StringValue(a.CidrBlockState.State)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
 func expandFieldToMatch(d map[string]interface{}) *waf.FieldToMatch {
 	ftm := &waf.FieldToMatch{
 		Type: aws.String(d["type"].(string)),
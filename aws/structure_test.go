@@ -149,6 +149,56 @@ func TestExpandIPPerms(t *testing.T) {
 	}
 }
 
+func TestExpandIPPerms_description(t *testing.T) {
+	expanded := []interface{}{
+		map[string]interface{}{
+			"protocol":         "tcp",
+			"from_port":        443,
+			"to_port":          443,
+			"cidr_blocks":      []interface{}{"10.0.0.0/8"},
+			"ipv6_cidr_blocks": []interface{}{"::/0"},
+			"prefix_list_ids":  []interface{}{"pl-12345678"},
+			"security_groups":  schema.NewSet(schema.HashString, []interface{}{"sg-11111"}),
+			"description":      "HTTPS",
+		},
+	}
+	group := &ec2.SecurityGroup{
+		GroupId: aws.String("foo"),
+		VpcId:   aws.String("bar"),
+	}
+	perms, err := expandIPPerms(group, expanded)
+	if err != nil {
+		t.Fatalf("error expanding perms: %v", err)
+	}
+
+	expected := []*ec2.IpPermission{
+		{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(int64(443)),
+			ToPort:     aws.Int64(int64(443)),
+			IpRanges: []*ec2.IpRange{
+				{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("HTTPS")},
+			},
+			Ipv6Ranges: []*ec2.Ipv6Range{
+				{CidrIpv6: aws.String("::/0"), Description: aws.String("HTTPS")},
+			},
+			PrefixListIds: []*ec2.PrefixListId{
+				{PrefixListId: aws.String("pl-12345678"), Description: aws.String("HTTPS")},
+			},
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{
+				{GroupId: aws.String("sg-11111"), Description: aws.String("HTTPS")},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(perms, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			perms,
+			expected)
+	}
+}
+
 func TestExpandIPPerms_NegOneProtocol(t *testing.T) {
 	hash := schema.HashString
 
@@ -1174,6 +1224,43 @@ func TestFlattenPolicyAttributes(t *testing.T) {
 	}
 }
 
+func TestFlattenVpcCidrBlockAssociations(t *testing.T) {
+	input := []*ec2.VpcCidrBlockAssociation{
+		&ec2.VpcCidrBlockAssociation{
+			AssociationId:  aws.String("vpc-cidr-assoc-1"),
+			CidrBlock:      aws.String("10.0.0.0/16"),
+			CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String("associated")},
+		},
+		&ec2.VpcCidrBlockAssociation{
+			AssociationId:  aws.String("vpc-cidr-assoc-2"),
+			CidrBlock:      aws.String("10.1.0.0/16"),
+			CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String("disassociated")},
+		},
+		&ec2.VpcCidrBlockAssociation{
+			AssociationId:  aws.String("vpc-cidr-assoc-3"),
+			CidrBlock:      aws.String("10.2.0.0/16"),
+			CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String("associating")},
+		},
+	}
+	expected := []map[string]interface{}{
+		{
+			"association_id": "vpc-cidr-assoc-1",
+			"cidr_block":     "10.0.0.0/16",
+			"state":          "associated",
+		},
+		{
+			"association_id": "vpc-cidr-assoc-3",
+			"cidr_block":     "10.2.0.0/16",
+			"state":          "associating",
+		},
+	}
+
+	output := flattenVpcCidrBlockAssociations(input)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, expected)
+	}
+}
+
 func TestNormalizeJsonString(t *testing.T) {
 	var err error
 	var actual string
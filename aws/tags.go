@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -32,49 +31,132 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
+// tagsWithDefaults returns the provider's default_tags merged with the
+// given resource level tags. Resource level values win on conflict.
+func tagsWithDefaults(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if client, ok := meta.(*AWSClient); ok {
+		for k, v := range client.defaultTags {
+			result[k] = v
+		}
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
 
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
+	return result
+}
+
+// tagKeyIgnored reports whether the given tag key is matched by the
+// provider's ignore_tags configuration.
+func tagKeyIgnored(meta interface{}, k string) bool {
+	client, ok := meta.(*AWSClient)
+	if !ok {
+		return false
+	}
+	for _, v := range client.ignoreTagKeys {
+		if k == v {
+			return true
+		}
+	}
+	for _, v := range client.ignoreTagKeyPrefixes {
+		if strings.HasPrefix(k, v) {
+			log.Printf("[DEBUG] Tag %s matches ignored prefix %s, ignoring.", k, v)
+			return true
+		}
+	}
+	return false
+}
+
+// tagsWithoutIgnored returns a copy of tags without the keys matched by
+// the provider's ignore_tags configuration.
+func tagsWithoutIgnored(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range tags {
+		if !tagKeyIgnored(meta, k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// getTagsChange is the default_tags aware counterpart of
+// d.GetChange("tags"). Both maps are merged with the provider's default
+// tags so that callers diff the full set of tags present on the resource.
+// A new resource has no tags yet, so the old map is empty in that case.
+// Keys matched by ignore_tags are left out so they are never touched.
+func getTagsChange(d *schema.ResourceData, meta interface{}) (map[string]interface{}, map[string]interface{}) {
+	oraw, nraw := d.GetChange("tags")
+	o := make(map[string]interface{})
+	if !d.IsNewResource() {
+		o = tagsWithoutIgnored(meta, tagsWithDefaults(meta, oraw.(map[string]interface{})))
+	}
+	n := tagsWithoutIgnored(meta, tagsWithDefaults(meta, nraw.(map[string]interface{})))
+
+	return o, n
+}
+
+// tagsNeedUpdate reports whether the tags of d have to be (re)applied,
+// either because they changed or because the resource was just created
+// and must receive the provider's default tags.
+func tagsNeedUpdate(d *schema.ResourceData) bool {
+	return d.HasChange("tags") || d.IsNewResource()
+}
+
+// tagsForState returns the subset of the tags read from the API that
+// should be recorded in the "tags" attribute. Keys matched by ignore_tags
+// are dropped. Provider default tags are left out unless the resource sets
+// the key itself or the value in AWS no longer matches the default, so
+// that they never show up as drift.
+func tagsForState(d *schema.ResourceData, meta interface{}, tags map[string]string) map[string]string {
+	client, ok := meta.(*AWSClient)
+	if !ok {
+		return tags
+	}
+
+	configured := d.Get("tags").(map[string]interface{})
+	result := make(map[string]string)
+	for k, v := range tags {
+		if tagKeyIgnored(meta, k) {
+			continue
+		}
+		if dv, ok := client.defaultTags[k]; ok && dv == v {
+			if _, ok := configured[k]; !ok {
+				continue
 			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData, meta interface{}) error {
+	return updateKeyValueTags(d, meta, keyValueTagsUpdater{
+		remove: func(tags keyValueTags) error {
 			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
 				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
+				TagKeys:      aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+			return err
+		},
+		create: func(tags keyValueTags) error {
 			_, err := conn.AddTags(&elbv2.AddTagsInput{
 				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
+				Tags:         tags.ELBv2Tags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
+			return err
+		},
+	})
 }
 
-func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("volume_tags") {
 		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		o := tagsWithoutIgnored(meta, oraw.(map[string]interface{}))
+		n := tagsWithoutIgnored(meta, nraw.(map[string]interface{}))
+		create, remove := diffKeyValueTags(newKeyValueTags(o), newKeyValueTags(n))
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
@@ -82,42 +164,14 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		}
 
 		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: volumeIds,
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
+			log.Printf("[DEBUG] Removing volume tags: %q from %s", remove.Keys(), d.Id())
+			if err := deleteEc2Tags(conn, volumeIds, remove, 2*time.Minute); err != nil {
 				return err
 			}
 		}
 		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: volumeIds,
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
+			log.Printf("[DEBUG] Creating volume tags: %q for %s", create.Keys(), d.Id())
+			if err := createEc2Tags(conn, volumeIds, create, 2*time.Minute); err != nil {
 				return err
 			}
 		}
@@ -128,261 +182,209 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
-	}
+func setTags(conn *ec2.EC2, d *schema.ResourceData, meta interface{}) error {
+	ids := []*string{aws.String(d.Id())}
+
+	return updateKeyValueTags(d, meta, keyValueTagsUpdater{
+		remove: func(tags keyValueTags) error {
+			return deleteEc2Tags(conn, ids, tags, 5*time.Minute)
+		},
+		create: func(tags keyValueTags) error {
+			return createEc2Tags(conn, ids, tags, 5*time.Minute)
+		},
+	})
+}
 
-	return nil
+// createEc2Tags creates the tags on the given resources, retrying while
+// newly created resources are not visible to the tagging API yet.
+func createEc2Tags(conn *ec2.EC2, ids []*string, tags keyValueTags, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: ids,
+			Tags:      tags.EC2Tags(),
+		})
+		return ec2TagsRetryError(err)
+	})
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+// deleteEc2Tags deletes the tags from the given resources, retrying while
+// newly created resources are not visible to the tagging API yet.
+func deleteEc2Tags(conn *ec2.EC2, ids []*string, tags keyValueTags, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
+			Resources: ids,
+			Tags:      tags.EC2Tags(),
+		})
+		return ec2TagsRetryError(err)
+	})
+}
 
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t)
+func ec2TagsRetryError(err error) *resource.RetryError {
+	if err != nil {
+		ec2err, ok := err.(awserr.Error)
+		if ok && strings.Contains(ec2err.Code(), ".NotFound") {
+			return resource.RetryableError(err) // retry
 		}
+		return resource.NonRetryableError(err)
 	}
+	return nil
+}
 
-	return tagsFromMap(create), remove
+// keyValueTagsFromEC2 converts EC2 tags to keyValueTags.
+func keyValueTagsFromEC2(ts []*ec2.Tag) keyValueTags {
+	tags := make(keyValueTags)
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
+// EC2Tags returns the tags as EC2 tags.
+func (tags keyValueTags) EC2Tags() []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ec2.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
+			Value: aws.String(tags[k]),
+		})
 	}
 
 	return result
 }
 
+// diffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
+	create, remove := diffKeyValueTags(keyValueTagsFromEC2(oldTags), keyValueTagsFromEC2(newTags))
+	return create.EC2Tags(), remove.EC2Tags()
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
+	return newKeyValueTags(m).IgnoreAws().EC2Tags()
+}
+
 // tagsToMap turns the list of tags into a map.
 func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsFromEC2(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsFromELBv2 converts ELBv2 tags to keyValueTags.
+func keyValueTagsFromELBv2(ts []*elbv2.Tag) keyValueTags {
+	tags := make(keyValueTags)
 	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+// ELBv2Tags returns the tags as ELBv2 tags.
+func (tags keyValueTags) ELBv2Tags() []*elbv2.Tag {
+	result := make([]*elbv2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elbv2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
 
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	return result
+}
 
-	return tagsFromMapELBv2(create), remove
+func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
+	create, remove := diffKeyValueTags(keyValueTagsFromELBv2(oldTags), keyValueTagsFromELBv2(newTags))
+	return create.ELBv2Tags(), remove.ELBv2Tags()
 }
 
 // tagsToMapELBv2 turns the list of tags into a map.
 func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyValueTagsFromELBv2(ts).IgnoreAws().Map()
 }
 
 // tagsFromMapELBv2 returns the tags for the given map of data.
 func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().ELBv2Tags()
 }
 
 // tagIgnored compares a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// keyValueTagsFromDynamoDb converts DynamoDB tags to keyValueTags.
+func keyValueTagsFromDynamoDb(ts []*dynamodb.Tag) keyValueTags {
+	tags := make(keyValueTags)
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	return false
+
+	return tags
 }
 
-// and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// DynamoDbTags returns the tags as DynamoDB tags.
+func (tags keyValueTags) DynamoDbTags() []*dynamodb.Tag {
+	result := make([]*dynamodb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
-	return false
+
+	return result
 }
 
 // tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
 func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
-	return result
+	return keyValueTagsFromDynamoDb(ts).IgnoreAws().Map()
 }
 
 // tagsFromMapDynamoDb returns the tags for a given map
 func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
-	result := make([]*dynamodb.Tag, 0, len(m))
-	for k, v := range m {
-		t := &dynamodb.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		result = append(result, t)
-	}
-	return result
+	return newKeyValueTags(m).IgnoreAws().DynamoDbTags()
 }
 
 // setTagsDynamoDb is a helper to set the tags for a dynamoDB resource
 // This is needed because dynamodb requires a completely different set and delete
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
-func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		arn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
+func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData, meta interface{}) error {
+	arn := d.Get("arn").(string)
+
+	return updateKeyValueTags(d, meta, keyValueTagsUpdater{
+		remove: func(tags keyValueTags) error {
+			return resource.Retry(2*time.Minute, func() *resource.RetryError {
 				_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
 					ResourceArn: aws.String(arn),
-					TagKeys:     remove,
+					TagKeys:     aws.StringSlice(tags.Keys()),
 				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), "ResourceNotFoundException") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+				return dynamoDbTagsRetryError(err)
 			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+		},
+		create: func(tags keyValueTags) error {
+			return resource.Retry(2*time.Minute, func() *resource.RetryError {
 				_, err := conn.TagResource(&dynamodb.TagResourceInput{
 					ResourceArn: aws.String(arn),
-					Tags:        create,
+					Tags:        tags.DynamoDbTags(),
 				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), "ResourceNotFoundException") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+				return dynamoDbTagsRetryError(err)
 			})
-			if err != nil {
-				return err
-			}
+		},
+	})
+}
+
+func dynamoDbTagsRetryError(err error) *resource.RetryError {
+	if err != nil {
+		ec2err, ok := err.(awserr.Error)
+		if ok && strings.Contains(ec2err.Code(), "ResourceNotFoundException") {
+			return resource.RetryableError(err) // retry
 		}
+		return resource.NonRetryableError(err)
 	}
-
 	return nil
 }
 
@@ -390,18 +392,6 @@ func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
 // and returns the set of tags that must be created as a map, and returns a list of tag keys
 // that must be destroyed.
 func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*string) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	var remove []*string
-	for _, t := range oldTags {
-		// Verify the old tag is not a tag we're currently attempting to create
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t.Key)
-		}
-	}
-	return tagsFromMapDynamoDb(create), remove
+	create, remove := diffKeyValueTags(keyValueTagsFromDynamoDb(oldTags), keyValueTagsFromDynamoDb(newTags))
+	return create.DynamoDbTags(), aws.StringSlice(remove.Keys())
 }
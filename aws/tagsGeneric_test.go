@@ -44,6 +44,18 @@ func TestDiffGenericTags(t *testing.T) {
 				"foo": "bar",
 			},
 		},
+
+		// Unchanged
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "bar",
+			},
+			Create: map[string]string{},
+			Remove: map[string]string{},
+		},
 	}
 
 	for i, tc := range cases {
@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/inspector"
 )
@@ -12,63 +9,39 @@ import (
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsInspector(oldTags, newTags []*inspector.ResourceGroupTag) ([]*inspector.ResourceGroupTag, []*inspector.ResourceGroupTag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*inspector.ResourceGroupTag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapInspector(create), remove
+	create, remove := diffKeyValueTags(keyValueTagsFromInspector(oldTags), keyValueTagsFromInspector(newTags))
+	return create.InspectorTags(), remove.InspectorTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapInspector(m map[string]interface{}) []*inspector.ResourceGroupTag {
-	var result []*inspector.ResourceGroupTag
-	for k, v := range m {
-		t := &inspector.ResourceGroupTag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredInspector(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().InspectorTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapInspector(ts []*inspector.ResourceGroupTag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsFromInspector(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsFromInspector converts Inspector tags to keyValueTags.
+func keyValueTagsFromInspector(ts []*inspector.ResourceGroupTag) keyValueTags {
+	tags := make(keyValueTags)
 	for _, t := range ts {
-		if !tagIgnoredInspector(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredInspector(t *inspector.ResourceGroupTag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// InspectorTags returns the tags as Inspector tags.
+func (tags keyValueTags) InspectorTags() []*inspector.ResourceGroupTag {
+	result := make([]*inspector.ResourceGroupTag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &inspector.ResourceGroupTag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
-	return false
+
+	return result
 }
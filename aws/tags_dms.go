@@ -7,43 +7,16 @@ import (
 )
 
 func dmsTagsToMap(tags []*dms.Tag) map[string]string {
-	result := make(map[string]string)
-
-	for _, tag := range tags {
-		result[*tag.Key] = *tag.Value
-	}
-
-	return result
+	return keyValueTagsFromDms(tags).Map()
 }
 
 func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
-	result := make([]*dms.Tag, 0, len(m))
-
-	for k, v := range m {
-		result = append(result, &dms.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return newKeyValueTags(m).DmsTags()
 }
 
 func dmsDiffTags(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	remove := []*dms.Tag{}
-	for _, t := range oldTags {
-		v, ok := create[*t.Key]
-		if !ok || v != *t.Value {
-			remove = append(remove, t)
-		}
-	}
-
-	return dmsTagsFromMap(create), remove
+	create, remove := diffKeyValueTags(keyValueTagsFromDms(oldTags), keyValueTagsFromDms(newTags))
+	return create.DmsTags(), remove.DmsTags()
 }
 
 func dmsGetTagKeys(tags []*dms.Tag) []*string {
@@ -56,36 +29,44 @@ func dmsGetTagKeys(tags []*dms.Tag) []*string {
 	return keys
 }
 
-func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+func keyValueTagsFromDms(ts []*dms.Tag) keyValueTags {
+	tags := make(keyValueTags)
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+func (tags keyValueTags) DmsTags() []*dms.Tag {
+	result := make([]*dms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dms.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	return result
+}
 
-		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
+func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
 
-		if len(remove) > 0 {
+	return updateKeyValueTags(d, meta, keyValueTagsUpdater{
+		remove: func(tags keyValueTags) error {
 			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
 				ResourceArn: aws.String(arn),
-				TagKeys:     dmsGetTagKeys(remove),
+				TagKeys:     aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				return err
-			}
-		}
-
-		if len(add) > 0 {
+			return err
+		},
+		create: func(tags keyValueTags) error {
 			_, err := conn.AddTagsToResource(&dms.AddTagsToResourceInput{
 				ResourceArn: aws.String(arn),
-				Tags:        add,
+				Tags:        tags.DmsTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
+			return err
+		},
+	})
 }
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// keyValueTags is a service agnostic representation of the tags of a
+// resource. Every service specific tag type is converted to and from it,
+// so that diffing, filtering and updating tags is implemented only once.
+type keyValueTags map[string]string
+
+// newKeyValueTags builds keyValueTags from the map types used for tags in
+// the schema and in the service APIs.
+func newKeyValueTags(i interface{}) keyValueTags {
+	tags := make(keyValueTags)
+
+	switch m := i.(type) {
+	case map[string]interface{}:
+		for k, v := range m {
+			tags[k] = v.(string)
+		}
+	case map[string]string:
+		for k, v := range m {
+			tags[k] = v
+		}
+	case map[string]*string:
+		for k, v := range m {
+			tags[k] = aws.StringValue(v)
+		}
+	}
+
+	return tags
+}
+
+// tagIgnoredAws reports whether the tag key is reserved by AWS. Such tags
+// are set by AWS itself and can be neither managed nor removed.
+func tagIgnoredAws(k string) bool {
+	if strings.HasPrefix(k, "aws:") {
+		log.Printf("[DEBUG] Found AWS specific tag %s, ignoring.", k)
+		return true
+	}
+	return false
+}
+
+// IgnoreAws returns a copy of tags without the keys reserved by AWS.
+func (tags keyValueTags) IgnoreAws() keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if !tagIgnoredAws(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Removed returns the tags that are not present with the same value in
+// newTags, i.e. the tags that are deleted or whose value is changed.
+func (tags keyValueTags) Removed(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if nv, ok := newTags[k]; !ok || nv != v {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the tags of newTags that are not present with the same
+// value in tags, i.e. the tags that are added or whose value is changed.
+func (tags keyValueTags) Updated(newTags keyValueTags) keyValueTags {
+	return newTags.Removed(tags)
+}
+
+// Keys returns the sorted tag keys.
+func (tags keyValueTags) Keys() []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Map returns the tags as the map type stored in state.
+func (tags keyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Interface returns the tags as the map type read from the schema.
+func (tags keyValueTags) Interface() map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Pointers returns the tags as the map type used by services that accept
+// tags as a plain map, such as Lambda or OpsWorks.
+func (tags keyValueTags) Pointers() map[string]*string {
+	result := make(map[string]*string, len(tags))
+	for k, v := range tags {
+		result[k] = aws.String(v)
+	}
+
+	return result
+}
+
+// diffKeyValueTags takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed. Tags reserved by AWS are never part of either set.
+func diffKeyValueTags(oldTags, newTags keyValueTags) (keyValueTags, keyValueTags) {
+	oldTags, newTags = oldTags.IgnoreAws(), newTags.IgnoreAws()
+
+	return oldTags.Updated(newTags), oldTags.Removed(newTags)
+}
+
+// keyValueTagsUpdater holds the calls that apply a tag diff to a single
+// resource through its service API.
+type keyValueTagsUpdater struct {
+	remove func(tags keyValueTags) error
+	create func(tags keyValueTags) error
+}
+
+// updateKeyValueTags is the shared implementation of the set*Tags helpers.
+// It diffs the "tags" of d, taking default_tags and ignore_tags into
+// account, and removes stale tags before creating new ones.
+func updateKeyValueTags(d *schema.ResourceData, meta interface{}, u keyValueTagsUpdater) error {
+	if !tagsNeedUpdate(d) {
+		return nil
+	}
+
+	o, n := getTagsChange(d, meta)
+	create, remove := diffKeyValueTags(newKeyValueTags(o), newKeyValueTags(n))
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags: %q from %s", remove.Keys(), d.Id())
+		if err := u.remove(remove); err != nil {
+			return err
+		}
+	}
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %q for %s", create.Keys(), d.Id())
+		if err := u.create(create); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
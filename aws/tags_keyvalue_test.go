@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestNewKeyValueTags(t *testing.T) {
+	expected := keyValueTags{
+		"foo": "bar",
+		"bar": "baz",
+	}
+
+	cases := []interface{}{
+		map[string]interface{}{"foo": "bar", "bar": "baz"},
+		map[string]string{"foo": "bar", "bar": "baz"},
+		map[string]*string{"foo": aws.String("bar"), "bar": aws.String("baz")},
+	}
+
+	for i, tc := range cases {
+		tags := newKeyValueTags(tc)
+		if !reflect.DeepEqual(tags, expected) {
+			t.Fatalf("%d: bad: %#v", i, tags)
+		}
+	}
+}
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	tags := keyValueTags{
+		"aws:cloudformation:logical-id": "foo",
+		"aws:foo:bar":                   "baz",
+		"Name":                          "test",
+	}
+
+	result := tags.IgnoreAws()
+	expected := keyValueTags{"Name": "test"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("bad: %#v", result)
+	}
+	if len(tags) != 3 {
+		t.Fatalf("original tags were modified: %#v", tags)
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	tags := keyValueTags{
+		"foo": "1",
+		"bar": "2",
+		"baz": "3",
+	}
+
+	keys := tags.Keys()
+	expected := []string{"bar", "baz", "foo"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("bad: %#v", keys)
+	}
+}
+
+func TestDiffKeyValueTags(t *testing.T) {
+	cases := []struct {
+		Old, New       keyValueTags
+		Create, Remove keyValueTags
+	}{
+		// Basic add/remove
+		{
+			Old:    keyValueTags{"foo": "bar"},
+			New:    keyValueTags{"bar": "baz"},
+			Create: keyValueTags{"bar": "baz"},
+			Remove: keyValueTags{"foo": "bar"},
+		},
+
+		// Modify
+		{
+			Old:    keyValueTags{"foo": "bar"},
+			New:    keyValueTags{"foo": "baz"},
+			Create: keyValueTags{"foo": "baz"},
+			Remove: keyValueTags{"foo": "bar"},
+		},
+
+		// Unchanged tags are left alone
+		{
+			Old:    keyValueTags{"foo": "bar", "bar": "baz"},
+			New:    keyValueTags{"foo": "bar", "baz": "qux"},
+			Create: keyValueTags{"baz": "qux"},
+			Remove: keyValueTags{"bar": "baz"},
+		},
+
+		// AWS tags are never created nor removed
+		{
+			Old:    keyValueTags{"aws:foo": "bar"},
+			New:    keyValueTags{"aws:bar": "baz"},
+			Create: keyValueTags{},
+			Remove: keyValueTags{},
+		},
+	}
+
+	for i, tc := range cases {
+		c, r := diffKeyValueTags(tc.Old, tc.New)
+		if !reflect.DeepEqual(c, tc.Create) {
+			t.Fatalf("%d: bad create: %#v", i, c)
+		}
+		if !reflect.DeepEqual(r, tc.Remove) {
+			t.Fatalf("%d: bad remove: %#v", i, r)
+		}
+	}
+}
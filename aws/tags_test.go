@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -62,6 +63,172 @@ func TestDiffTags(t *testing.T) {
 	}
 }
 
+func TestTagsWithDefaults(t *testing.T) {
+	meta := &AWSClient{
+		defaultTags: map[string]string{
+			"owner":       "ops",
+			"environment": "production",
+		},
+	}
+
+	cases := []struct {
+		Tags     map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"owner":       "ops",
+				"environment": "production",
+			},
+		},
+		{
+			Tags: map[string]interface{}{
+				"Name":        "web",
+				"environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"Name":        "web",
+				"owner":       "ops",
+				"environment": "staging",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := tagsWithDefaults(meta, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestTagsForState(t *testing.T) {
+	meta := &AWSClient{
+		defaultTags: map[string]string{
+			"owner":       "ops",
+			"environment": "production",
+		},
+	}
+	s := map[string]*schema.Schema{
+		"tags": tagsSchema(),
+	}
+
+	cases := []struct {
+		Configured map[string]interface{}
+		Remote     map[string]string
+		Expected   map[string]string
+	}{
+		// Default tags are hidden from state
+		{
+			Configured: map[string]interface{}{
+				"Name": "web",
+			},
+			Remote: map[string]string{
+				"Name":        "web",
+				"owner":       "ops",
+				"environment": "production",
+			},
+			Expected: map[string]string{
+				"Name": "web",
+			},
+		},
+		// Keys also set on the resource are kept
+		{
+			Configured: map[string]interface{}{
+				"owner": "ops",
+			},
+			Remote: map[string]string{
+				"owner":       "ops",
+				"environment": "production",
+			},
+			Expected: map[string]string{
+				"owner": "ops",
+			},
+		},
+		// Default tags changed outside of Terraform show up as drift
+		{
+			Configured: map[string]interface{}{},
+			Remote: map[string]string{
+				"owner":       "someone-else",
+				"environment": "production",
+			},
+			Expected: map[string]string{
+				"owner": "someone-else",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+			"tags": tc.Configured,
+		})
+		actual := tagsForState(d, meta, tc.Remote)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestTagKeyIgnored(t *testing.T) {
+	meta := &AWSClient{
+		ignoreTagKeys:        []string{"Owner"},
+		ignoreTagKeyPrefixes: []string{"kubernetes.io/", "cost:"},
+	}
+
+	cases := []struct {
+		Key      string
+		Expected bool
+	}{
+		{Key: "Owner", Expected: true},
+		{Key: "OwnerTeam", Expected: false},
+		{Key: "kubernetes.io/cluster/prod", Expected: true},
+		{Key: "cost:center", Expected: true},
+		{Key: "Name", Expected: false},
+	}
+
+	for _, tc := range cases {
+		if actual := tagKeyIgnored(meta, tc.Key); actual != tc.Expected {
+			t.Fatalf("%s: expected %t, got %t", tc.Key, tc.Expected, actual)
+		}
+	}
+}
+
+func TestGetTagsChange_ignoreTags(t *testing.T) {
+	meta := &AWSClient{
+		ignoreTagKeyPrefixes: []string{"kubernetes.io/"},
+	}
+	s := map[string]*schema.Schema{
+		"tags": tagsSchema(),
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Name":                       "web",
+			"kubernetes.io/cluster/prod": "owned",
+		},
+	})
+	d.SetId("i-abcd1234")
+
+	o, n := getTagsChange(d, meta)
+	if len(o) != 0 {
+		t.Fatalf("bad old tags: %#v", o)
+	}
+	expected := map[string]interface{}{
+		"Name": "web",
+	}
+	if !reflect.DeepEqual(n, expected) {
+		t.Fatalf("bad new tags: %#v", n)
+	}
+
+	remote := map[string]string{
+		"Name":                       "web",
+		"kubernetes.io/cluster/prod": "owned",
+	}
+	if actual := tagsForState(d, meta, remote); !reflect.DeepEqual(actual, map[string]string{"Name": "web"}) {
+		t.Fatalf("bad state tags: %#v", actual)
+	}
+}
+
 func TestIgnoringTags(t *testing.T) {
 	var ignoredTags []*ec2.Tag
 	ignoredTags = append(ignoredTags, &ec2.Tag{
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+// ServiceThrottling holds the client-side rate limit of the API requests made
+// to a service and the error codes of the service which are retried on top of
+// the ones retried by the SDK.
+type ServiceThrottling struct {
+	RequestsPerSecond   float64
+	Burst               int
+	RetryableErrorCodes []string
+}
+
+// retryableErrorRule makes the errors with one of the codes retryable. The
+// rule only applies to the operations whose name starts with one of the
+// operation prefixes, or to all operations when there are none.
+type retryableErrorRule struct {
+	Codes             []string
+	OperationPrefixes []string
+}
+
+func (rule retryableErrorRule) matches(r *request.Request) bool {
+	err, ok := r.Error.(awserr.Error)
+	if !ok || err == nil {
+		return false
+	}
+
+	if len(rule.OperationPrefixes) > 0 {
+		matched := false
+		for _, prefix := range rule.OperationPrefixes {
+			if strings.HasPrefix(r.Operation.Name, prefix) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	for _, code := range rule.Codes {
+		if err.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultRetryableErrors lists, per service, the transient errors which are
+// not retried by the SDK.
+var defaultRetryableErrors = map[string][]retryableErrorRule{
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	"applicationautoscaling": {
+		{
+			Codes:             []string{applicationautoscaling.ErrCodeFailedResourceAccessException},
+			OperationPrefixes: []string{"Describe", "List"},
+		},
+	},
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
+	"kinesis": {
+		{
+			Codes:             []string{kinesis.ErrCodeLimitExceededException},
+			OperationPrefixes: []string{"Describe", "List"},
+		},
+	},
+}
+
+// retryableErrorRules returns the default rules of the service along with the
+// error codes configured for it.
+func (c *Config) retryableErrorRules(svc awsServiceClient) []retryableErrorRule {
+	rules := defaultRetryableErrors[svc.Name]
+	if t, ok := c.Throttling[svc.Name]; ok && len(t.RetryableErrorCodes) > 0 {
+		rules = append(rules[:len(rules):len(rules)], retryableErrorRule{Codes: t.RetryableErrorCodes})
+	}
+	return rules
+}
+
+// addThrottlingHandlers sets up the rate limit and the retryable errors of
+// the service on the handlers of its client.
+func (c *Config) addThrottlingHandlers(handlers *request.Handlers, svc awsServiceClient) {
+	if t, ok := c.Throttling[svc.Name]; ok && t.RequestsPerSecond > 0 {
+		handlers.Sign.PushFrontNamed(rateLimitHandler(c.tokenBucket(svc.Name, t)))
+	}
+
+	if rules := c.retryableErrorRules(svc); len(rules) > 0 {
+		handlers.Retry.PushBackNamed(retryableErrorsHandler(rules))
+	}
+}
+
+// tokenBucket returns the token bucket of the service, created on first use.
+func (c *Config) tokenBucket(name string, t *ServiceThrottling) *tokenBucket {
+	if bucket, ok := c.tokenBuckets[name]; ok {
+		return bucket
+	}
+
+	if c.tokenBuckets == nil {
+		c.tokenBuckets = make(map[string]*tokenBucket)
+	}
+	bucket := newTokenBucket(t.RequestsPerSecond, t.Burst)
+	c.tokenBuckets[name] = bucket
+	return bucket
+}
+
+// rateLimitHandler delays the requests, including their retries, until a
+// token is available in the bucket. It runs first when signing the request so
+// the signature does not age while waiting.
+func rateLimitHandler(bucket *tokenBucket) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			delay := bucket.reserve(time.Now())
+			if delay <= 0 {
+				return
+			}
+
+			log.Printf("[DEBUG] Rate limiting %s/%s request for %s",
+				r.ClientInfo.ServiceName, r.Operation.Name, delay)
+			if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+			}
+		},
+	}
+}
+
+// retryableErrorsHandler marks the request as retryable when its error
+// matches one of the rules. The retryer then decides whether the request is
+// retried depending on the retries left.
+func retryableErrorsHandler(rules []retryableErrorRule) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.RetryableErrorsHandler",
+		Fn: func(r *request.Request) {
+			for _, rule := range rules {
+				if rule.matches(r) {
+					r.Retryable = aws.Bool(true)
+					return
+				}
+			}
+		},
+	}
+}
+
+// maxBackoffRetryer is the DefaultRetryer of the SDK with an upper limit on
+// the delay between retries.
+type maxBackoffRetryer struct {
+	client.DefaultRetryer
+
+	MaxBackoff time.Duration
+}
+
+func (d maxBackoffRetryer) RetryRules(r *request.Request) time.Duration {
+	delay := d.DefaultRetryer.RetryRules(r)
+	if d.MaxBackoff > 0 && delay > d.MaxBackoff {
+		return d.MaxBackoff
+	}
+	return delay
+}
+
+// tokenBucket is a rate limiter refilled with rate tokens per second, up to
+// burst tokens.
+type tokenBucket struct {
+	mu sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait before the token becomes available. Tokens may be reserved ahead of
+// time, so concurrent callers are spread at the rate of the bucket.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
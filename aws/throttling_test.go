@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+func TestConfigTokenBucket_shared(t *testing.T) {
+	config := &Config{
+		Throttling: map[string]*ServiceThrottling{
+			"kinesis": {RequestsPerSecond: 1, Burst: 1},
+		},
+	}
+
+	var svc awsServiceClient
+	for _, s := range awsServiceClients {
+		if s.Name == "kinesis" {
+			svc = s
+		}
+	}
+
+	sess := session.New(&aws.Config{Region: aws.String("us-west-2")})
+	config.serviceSession(sess, svc)
+	bucket := config.tokenBuckets["kinesis"]
+	if bucket == nil {
+		t.Fatal("Expected a token bucket for kinesis")
+	}
+
+	config.serviceSession(sess, svc)
+	if config.tokenBuckets["kinesis"] != bucket {
+		t.Fatal("Expected the clients of kinesis to share their token bucket")
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(10, 2)
+	now := bucket.last
+
+	cases := []struct {
+		Elapsed  time.Duration
+		Expected time.Duration
+	}{
+		{0, 0},
+		{0, 0},
+		{0, 100 * time.Millisecond},
+		{0, 200 * time.Millisecond},
+		{time.Second, 0},
+		{0, 0},
+		{0, 100 * time.Millisecond},
+	}
+
+	for i, tc := range cases {
+		now = now.Add(tc.Elapsed)
+		if delay := bucket.reserve(now); delay != tc.Expected {
+			t.Fatalf("%d: expected delay %s, got %s", i, tc.Expected, delay)
+		}
+	}
+}
+
+func TestMaxBackoffRetryer(t *testing.T) {
+	r := &request.Request{
+		RetryCount:   8,
+		HTTPResponse: &http.Response{StatusCode: 400},
+		Error:        awserr.New("Throttling", "Rate exceeded", nil),
+	}
+
+	retryer := maxBackoffRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: 25},
+		MaxBackoff:     5 * time.Second,
+	}
+	if delay := retryer.RetryRules(r); delay != 5*time.Second {
+		t.Fatalf("Expected delay to be capped to 5s, got %s", delay)
+	}
+
+	r.RetryCount = 0
+	if delay := retryer.RetryRules(r); delay > time.Second {
+		t.Fatalf("Expected delay of the first retry to be left as is, got %s", delay)
+	}
+}
+
+func TestConfigServiceSession_retryableErrorCodes(t *testing.T) {
+	ts := getMockedAwsApiServer("Kinesis", []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"POST", "/", `{"StreamName":"limited"}`},
+			Response: &awsMockResponse{400, `{"__type":"LimitExceededException","message":"Rate exceeded"}`, "application/x-amz-json-1.1"},
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"POST", "/", `{"StreamName":"busy"}`},
+			Response: &awsMockResponse{400, `{"__type":"ResourceInUseException","message":"Stream busy is in use"}`, "application/x-amz-json-1.1"},
+		},
+	})
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: awsCredentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-east-1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := awsServiceClient{Name: "kinesis"}
+
+	cases := []struct {
+		Name       string
+		Throttling map[string]*ServiceThrottling
+		Request    func(conn *kinesis.Kinesis) *request.Request
+		RetryCount int
+	}{
+		{
+			Name: "default rule",
+			Request: func(conn *kinesis.Kinesis) *request.Request {
+				req, _ := conn.DescribeStreamRequest(&kinesis.DescribeStreamInput{StreamName: aws.String("limited")})
+				return req
+			},
+			RetryCount: 2,
+		},
+		{
+			Name: "default rule on other operations",
+			Request: func(conn *kinesis.Kinesis) *request.Request {
+				req, _ := conn.DeleteStreamRequest(&kinesis.DeleteStreamInput{StreamName: aws.String("limited")})
+				return req
+			},
+			RetryCount: 0,
+		},
+		{
+			Name: "not configured",
+			Request: func(conn *kinesis.Kinesis) *request.Request {
+				req, _ := conn.DeleteStreamRequest(&kinesis.DeleteStreamInput{StreamName: aws.String("busy")})
+				return req
+			},
+			RetryCount: 0,
+		},
+		{
+			Name: "configured",
+			Throttling: map[string]*ServiceThrottling{
+				"kinesis": {RetryableErrorCodes: []string{kinesis.ErrCodeResourceInUseException}},
+			},
+			Request: func(conn *kinesis.Kinesis) *request.Request {
+				req, _ := conn.DeleteStreamRequest(&kinesis.DeleteStreamInput{StreamName: aws.String("busy")})
+				return req
+			},
+			RetryCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		config := &Config{
+			MaxRetries: 2,
+			MaxBackoff: time.Millisecond,
+			Throttling: tc.Throttling,
+			Endpoints:  map[string]string{"kinesis": ts.URL},
+		}
+		conn := kinesis.New(config.serviceSession(sess, svc))
+
+		req := tc.Request(conn)
+		if err := req.Send(); err == nil {
+			t.Fatalf("%s: expected an error", tc.Name)
+		}
+		if req.RetryCount != tc.RetryCount {
+			t.Fatalf("%s: expected %d retries, got %d", tc.Name, tc.RetryCount, req.RetryCount)
+		}
+	}
+}
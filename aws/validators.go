@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -773,6 +774,23 @@ func validateSecurityRuleType(v interface{}, k string) (ws []string, errors []er
 	return
 }
 
+func validateSecurityGroupRuleDescription(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 255 characters: %q", k, value))
+	}
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_IpRange.html
+	pattern := `^[A-Za-z0-9 \.\_\-\:\/\(\)\#\,\@\[\]\+\=\;\{\}\!\$\*]*$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+	return
+}
+
 func validateOnceAWeekWindowFormat(v interface{}, k string) (ws []string, errors []error) {
 	// valid time format is "ddd:hh24:mi"
 	validTimeFormat := "(sun|mon|tue|wed|thu|fri|sat):([0-1][0-9]|2[0-3]):([0-5][0-9])"
@@ -1419,3 +1437,52 @@ func validateSsmParameterType(v interface{}, k string) (ws []string, errors []er
 	}
 	return
 }
+
+func validateDurationString(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative: %s", k, value))
+	}
+	return
+}
+
+func validateLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasSuffix(k, "prefix") && len(value) < 3 {
+		errors = append(errors, fmt.Errorf("%q cannot be less than 3 characters", k))
+	} else if strings.HasSuffix(k, "prefix") && len(value) > 102 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 102 characters, name is limited to 128", k))
+	} else if !strings.HasSuffix(k, "prefix") && len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 128 characters", k))
+	} else if !regexp.MustCompile(`^[0-9a-zA-Z()./_\-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q can only contain alphanumerics and ()._/- characters", k))
+	}
+	return
+}
+
+func validateLaunchTemplateVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "$Latest" || value == "$Default" {
+		return
+	}
+	if n, err := strconv.Atoi(value); err != nil || n < 1 {
+		errors = append(errors, fmt.Errorf("%q must be a version number, $Latest or $Default, got %q", k, value))
+	}
+	return
+}
+
+func validateRFC3339TimeString(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a RFC3339 timestamp: %s", k, err))
+	}
+	return
+}
@@ -1148,6 +1148,35 @@ func TestValidateSecurityRuleType(t *testing.T) {
 	}
 }
 
+func TestValidateSecurityGroupRuleDescription(t *testing.T) {
+	validDescriptions := []string{
+		"",
+		"testrule",
+		"testRule",
+		"testRule 123",
+		`testRule 123 ._-:/()#,@[]+=;{}!$*`,
+	}
+	for _, v := range validDescriptions {
+		_, errors := validateSecurityGroupRuleDescription(v, "description")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid security group rule description: %q", v, errors)
+		}
+	}
+
+	invalidDescriptions := []string{
+		"`",
+		"%%",
+		`\`,
+		strings.Repeat("a", 256),
+	}
+	for _, v := range invalidDescriptions {
+		_, errors := validateSecurityGroupRuleDescription(v, "description")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid security group rule description", v)
+		}
+	}
+}
+
 func TestValidateOnceAWeekWindowFormat(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -2425,3 +2454,114 @@ func TestValidateSsmParameterType(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDurationString(t *testing.T) {
+	validDurations := []string{
+		"",
+		"30s",
+		"1m30s",
+		"500ms",
+	}
+	for _, v := range validDurations {
+		_, errors := validateDurationString(v, "max_backoff")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid duration: %q", v, errors)
+		}
+	}
+
+	invalidDurations := []string{
+		"30",
+		"thirty seconds",
+		"-5s",
+	}
+	for _, v := range invalidDurations {
+		_, errors := validateDurationString(v, "max_backoff")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid duration", v)
+		}
+	}
+}
+
+func TestValidateLaunchTemplateName(t *testing.T) {
+	validNames := []string{
+		"fooBAR123",
+		"tf-acc-test_(1)/web.server",
+	}
+	for _, v := range validNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid launch template name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"foo bar",
+		"foo*bar",
+		strings.Repeat("W", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid launch template name", v)
+		}
+	}
+
+	if _, errors := validateLaunchTemplateName(strings.Repeat("W", 103), "name_prefix"); len(errors) == 0 {
+		t.Fatal("A name prefix of 103 characters should be invalid")
+	}
+}
+
+func TestValidateLaunchTemplateVersion(t *testing.T) {
+	validVersions := []string{
+		"$Latest",
+		"$Default",
+		"1",
+		"42",
+	}
+	for _, v := range validVersions {
+		_, errors := validateLaunchTemplateVersion(v, "version")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid launch template version: %q", v, errors)
+		}
+	}
+
+	invalidVersions := []string{
+		"",
+		"0",
+		"-1",
+		"latest",
+		"$LATEST",
+	}
+	for _, v := range invalidVersions {
+		_, errors := validateLaunchTemplateVersion(v, "version")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid launch template version", v)
+		}
+	}
+}
+
+func TestValidateRFC3339TimeString(t *testing.T) {
+	validTimes := []string{
+		"2018-03-01T00:00:00Z",
+		"2018-03-01T12:30:00+02:00",
+	}
+	for _, v := range validTimes {
+		_, errors := validateRFC3339TimeString(v, "valid_until")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid RFC3339 time: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"2018-03-01",
+		"2018-03-01 00:00:00",
+		"tomorrow",
+	}
+	for _, v := range invalidTimes {
+		_, errors := validateRFC3339TimeString(v, "valid_until")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid RFC3339 time", v)
+		}
+	}
+}